@@ -0,0 +1,175 @@
+// Package diff implements the numerical differentiation of y=f(x) functions with finite differences and Richardson
+// extrapolation
+package diff
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+	"github.com/gonzalochief/NumericAll/utils"
+)
+
+var ErrInvalidDerivative = errors.New("the order of the derivative must be greater than 0")
+var ErrInvalidAccuracy = errors.New("the order of accuracy must be greater than 0")
+var ErrInvalidStep = errors.New("the step must be greater than 0")
+
+// Scheme selects the points used by a finite difference
+type Scheme int
+
+const (
+	Central  Scheme = iota // points at both sides of x, the order of accuracy is even
+	Forward                // x and points at its right
+	Backward               // x and points at its left
+)
+
+// Derivative estimates the n-th derivative of the function at x with a finite difference
+// The weights of the stencil are computed with the Fornberg's algorithm, so any derivative and order of accuracy can be
+// used. The central differences have an even order of accuracy, an odd order is raised to the next even one
+// Inputs:
+//
+//	y is the function function
+//	x is the point of evaluation
+//	n is the order of the derivative
+//	scheme is the finite difference (Central, Forward or Backward)
+//	order is the order of accuracy, the truncation error is O(h^order)
+//	h is the step, if it is 0 the step is chosen with Step
+//
+// Outputs:
+//
+//	d is the estimation of the n-th derivative
+func Derivative(y nonlineareq.YEqFuncx, x float64, n int, scheme Scheme, order int, h float64) (d float64, err error) {
+	offsets, weights, err := Stencil(n, scheme, order)
+	if err != nil {
+		return 0, err
+	}
+	if h == 0 {
+		h = Step(x, n, accuracy(scheme, order))
+	} else if !(h > 0) {
+		return 0, ErrInvalidStep
+	}
+	return apply(y, x, h, n, offsets, weights), nil
+}
+
+// DerivativeFunc returns the first derivative of the function estimated with Derivative and an automatic step, e.g. to
+// be used as dy by nonlineareq.NewtonRaphson when no analytic derivative exists
+// Inputs:
+//
+//	y is the function function
+//	scheme is the finite difference (Central, Forward or Backward)
+//	order is the order of accuracy
+//
+// Outputs:
+//
+//	dy is the derivative function
+func DerivativeFunc(y nonlineareq.YEqFuncx, scheme Scheme, order int) (dy nonlineareq.YEqFuncx, err error) {
+	offsets, weights, err := Stencil(1, scheme, order)
+	if err != nil {
+		return nil, err
+	}
+	order = accuracy(scheme, order)
+	return func(x float64) float64 {
+		return apply(y, x, Step(x, 1, order), 1, offsets, weights)
+	}, nil
+}
+
+// Step returns the step that balances the truncation error O(h^order) and the rounding error O(eps/h^n) of the n-th
+// derivative at x, h = eps^(1/(n+order)) * max(|x|, 1)
+func Step(x float64, n, order int) (h float64) {
+	h = math.Pow(utils.Epsilon[float64](), 1/float64(n+order)) * math.Max(math.Abs(x), 1)
+	// use the representable step to reduce the rounding error
+	return (x + h) - x
+}
+
+// Stencil returns the offsets (in steps) and the weights of the finite difference of the n-th derivative
+// Inputs:
+//
+//	n is the order of the derivative
+//	scheme is the finite difference (Central, Forward or Backward)
+//	order is the order of accuracy
+//
+// Outputs:
+//
+//	offsets are the points of the stencil, x + offsets[k]*h
+//	weights are the weights of the stencil, the derivative is sum(weights[k]*f(x + offsets[k]*h)) / h^n
+func Stencil(n int, scheme Scheme, order int) (offsets []int, weights []float64, err error) {
+	if n < 1 {
+		return nil, nil, ErrInvalidDerivative
+	}
+	if order < 1 {
+		return nil, nil, ErrInvalidAccuracy
+	}
+	order = accuracy(scheme, order)
+	switch scheme {
+	case Forward, Backward:
+		offsets = make([]int, n+order)
+		for k := range offsets {
+			offsets[k] = k
+			if scheme == Backward {
+				offsets[k] = k - (len(offsets) - 1)
+			}
+		}
+	default:
+		r := (n-1)/2 + order/2
+		offsets = make([]int, 2*r+1)
+		for k := range offsets {
+			offsets[k] = k - r
+		}
+	}
+	return offsets, fornberg(offsets, n), nil
+}
+
+// accuracy returns the order of accuracy used by the scheme, the central differences have an even order
+func accuracy(scheme Scheme, order int) int {
+	if scheme != Forward && scheme != Backward && order%2 == 1 {
+		return order + 1
+	}
+	return order
+}
+
+// apply evaluates the finite difference of the n-th derivative with the stencil
+func apply(y nonlineareq.YEqFuncx, x, h float64, n int, offsets []int, weights []float64) (d float64) {
+	for k, o := range offsets {
+		if weights[k] != 0 {
+			d += weights[k] * y(x+float64(o)*h)
+		}
+	}
+	return d / math.Pow(h, float64(n))
+}
+
+// fornberg returns the weights of the n-th derivative at 0 for the points z (Fornberg, 1988)
+func fornberg(z []int, n int) (weights []float64) {
+	c := make([][]float64, len(z))
+	for i := range c {
+		c[i] = make([]float64, n+1)
+	}
+	c[0][0] = 1
+	c1 := 1.0
+	c4 := float64(z[0])
+	for i := 1; i < len(z); i++ {
+		mn := min(i, n)
+		c2 := 1.0
+		c5 := c4
+		c4 = float64(z[i])
+		for j := 0; j < i; j++ {
+			c3 := float64(z[i] - z[j])
+			c2 *= c3
+			if j == i-1 {
+				for k := mn; k >= 1; k-- {
+					c[i][k] = c1 * (float64(k)*c[i-1][k-1] - c5*c[i-1][k]) / c2
+				}
+				c[i][0] = -c1 * c5 * c[i-1][0] / c2
+			}
+			for k := mn; k >= 1; k-- {
+				c[j][k] = (c4*c[j][k] - float64(k)*c[j][k-1]) / c3
+			}
+			c[j][0] = c4 * c[j][0] / c3
+		}
+		c1 = c2
+	}
+	weights = make([]float64, len(z))
+	for i := range z {
+		weights[i] = c[i][n]
+	}
+	return weights
+}
@@ -0,0 +1,95 @@
+package diff
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+type testStructDerivative struct {
+	TestCaseName string
+	N            int
+	Scheme       Scheme
+	Order        int
+	H            float64
+	Expected     float64
+	Tol          float64
+}
+
+func TestDerivative(t *testing.T) {
+	// f(x) = exp(x) sin(x) at x = 1
+	y := func(x float64) float64 {
+		return math.Exp(x) * math.Sin(x)
+	}
+	x := 1.0
+	d1 := math.Exp(x) * (math.Sin(x) + math.Cos(x))
+	d2 := 2 * math.Exp(x) * math.Cos(x)
+	d3 := 2 * math.Exp(x) * (math.Cos(x) - math.Sin(x))
+	testCases := []testStructDerivative{
+		{"central 2", 1, Central, 2, 0, d1, 1e-9},
+		{"central 4", 1, Central, 4, 0, d1, 1e-11},
+		{"central odd order", 1, Central, 3, 0, d1, 1e-11},
+		{"forward 1", 1, Forward, 1, 0, d1, 1e-7},
+		{"forward 3", 1, Forward, 3, 0, d1, 1e-10},
+		{"backward 2", 1, Backward, 2, 0, d1, 1e-9},
+		{"second derivative", 2, Central, 4, 0, d2, 1e-7},
+		{"third derivative", 3, Central, 2, 0, d3, 1e-4},
+		{"third derivative forward", 3, Forward, 2, 0, d3, 1e-3},
+		// the truncation error of the fixed step dominates
+		{"central 2 fixed step", 1, Central, 2, 1e-2, d1, 1e-4},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		d, err := Derivative(y, x, tc.N, tc.Scheme, tc.Order, tc.H)
+		if err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.TestCaseName, err)
+		} else if math.Abs(d-tc.Expected) > tc.Tol {
+			t.Errorf("wrong estimation for case: %s. expecting: %.12f, receiving %.12f", tc.TestCaseName, tc.Expected, d)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing error signals")
+	if _, err := Derivative(y, x, 0, Central, 2, 0); err != ErrInvalidDerivative {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrInvalidDerivative, err)
+	}
+	if _, err := Derivative(y, x, 1, Forward, 0, 0); err != ErrInvalidAccuracy {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrInvalidAccuracy, err)
+	}
+	if _, err := Derivative(y, x, 1, Forward, 1, -1e-3); err != ErrInvalidStep {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrInvalidStep, err)
+	}
+}
+
+func TestStencil(t *testing.T) {
+	// Test case: the classic central difference of the second derivative
+	offsets, weights, _ := Stencil(2, Central, 2)
+	if len(offsets) != 3 || offsets[0] != -1 || weights[0] != 1 || weights[1] != -2 || weights[2] != 1 {
+		t.Errorf("wrong stencil. expecting: [-1 0 1] [1 -2 1], receiving %v %v", offsets, weights)
+	}
+	// Test case: second order backward difference of the first derivative
+	offsets, weights, _ = Stencil(1, Backward, 2)
+	if len(offsets) != 3 || offsets[0] != -2 || weights[0] != 0.5 || weights[1] != -2 || weights[2] != 1.5 {
+		t.Errorf("wrong stencil. expecting: [-2 -1 0] [0.5 -2 1.5], receiving %v %v", offsets, weights)
+	}
+}
+
+func TestDerivativeFunc(t *testing.T) {
+	// ex. 2.2 solved with the numerical derivative
+	y := func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	dy, err := DerivativeFunc(y, Central, 2)
+	if err != nil {
+		t.Fatalf("unexpected error. %v", err)
+	}
+	p, _, _, _, err := nonlineareq.NewtonRaphson(y, dy, 1.5, 1e-12, 1e-12, 50)
+	if err != nil || math.Abs(p-1.365230013414097) > 1e-12 {
+		t.Errorf("wrong estimation. expecting: %f, receiving %f (%v)", 1.365230013414097, p, err)
+	}
+	if _, err = DerivativeFunc(y, Central, 0); err != ErrInvalidAccuracy {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrInvalidAccuracy, err)
+	}
+}
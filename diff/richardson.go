@@ -0,0 +1,73 @@
+package diff
+
+import (
+	"math"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+// Richardson estimates the n-th derivative of the function at x extrapolating the central differences (order 2) with the
+// steps h, h/2, h/4... (Richardson's extrapolation), where every column of the tableau removes the next even power of h
+// The tableau T(i,j) = T(i,j-1) + (T(i,j-1) - T(i-1,j-1)) / (4^j - 1) is built row by row (R. L. Burden and J. D. Faires,
+// Numerical Analysis, Section 4.2) and the error of the diagonal entry T(i,i) is estimated by its change from T(i-1,i-1)
+// The halvings stop when the change is below tol, or when it grew in the last two rows, because the rounding error of the
+// differences grows as the step shrinks and then dominates the truncation error
+// Inputs:
+//
+//	y is the function function
+//	x is the point of evaluation
+//	n is the order of the derivative
+//	h is the initial step, if it is 0, 0.1*max(|x|, 1) is used
+//	tol is the tolerance for the error estimation
+//	maxIter is the maximum number of halvings of the step
+//
+// Outputs:
+//
+//	d is the estimation of the n-th derivative (the diagonal entry with the smallest change)
+//	errEst is the estimation of the error of d
+//
+// nonlineareq.ErrMaxIter is returned (with the best estimation) if tol is not met after maxIter halvings
+func Richardson(y nonlineareq.YEqFuncx, x float64, n int, h, tol float64, maxIter int) (d, errEst float64, err error) {
+	offsets, weights, err := Stencil(n, Central, 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	if h == 0 {
+		h = 0.1 * math.Max(math.Abs(x), 1)
+	} else if !(h > 0) {
+		return 0, 0, ErrInvalidStep
+	}
+	prev := []float64{apply(y, x, h, n, offsets, weights)}
+	d = prev[0]
+	errEst = math.Inf(1)
+	lastChange := math.Inf(1)
+	growths := 0
+	for i := 1; i <= maxIter; i++ {
+		h /= 2
+		row := make([]float64, i+1)
+		row[0] = apply(y, x, h, n, offsets, weights)
+		pow4 := 1.0
+		for j := 1; j <= i; j++ {
+			pow4 *= 4
+			row[j] = row[j-1] + (row[j-1]-prev[j-1])/(pow4-1)
+		}
+		change := math.Abs(row[i] - prev[i-1])
+		if change < errEst {
+			d, errEst = row[i], change
+		}
+		if errEst < tol {
+			return d, errEst, nil
+		}
+		if change > lastChange {
+			growths++
+		} else {
+			growths = 0
+		}
+		if growths == 2 {
+			return d, errEst, nil
+		}
+		lastChange = change
+		prev = row
+	}
+	return d, errEst, nonlineareq.ErrMaxIter
+}
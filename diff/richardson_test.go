@@ -0,0 +1,51 @@
+package diff
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+type testStructRichardson struct {
+	TestCaseName string
+	Y            nonlineareq.YEqFuncx
+	X            float64
+	N            int
+	Expected     float64
+	Tol          float64
+}
+
+func TestRichardson(t *testing.T) {
+	testCases := []testStructRichardson{
+		{"exp", math.Exp, 1, 1, math.E, 1e-12},
+		{"sin second derivative", math.Sin, 0.5, 2, -math.Sin(0.5), 1e-9},
+		{"log", math.Log, 3, 1, 1.0 / 3, 1e-12},
+		{"polynomial third derivative", func(x float64) float64 { return x * x * x * x }, 2, 3, 48, 1e-6},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		d, errEst, err := Richardson(tc.Y, tc.X, tc.N, 0, tc.Tol, 20)
+		if err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.TestCaseName, err)
+		} else if math.Abs(d-tc.Expected) > tc.Tol || errEst > tc.Tol {
+			t.Errorf("wrong estimation for case: %s. expecting: %.15f, receiving %.15f (error estimation %e)", tc.TestCaseName, tc.Expected, d, errEst)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing error signals")
+	// Test case: the error estimation is returned with the best approximation
+	d, errEst, err := Richardson(math.Exp, 1, 1, 0, 1e-14, 1)
+	if err != nonlineareq.ErrMaxIter || math.Abs(d-math.E) > errEst {
+		t.Errorf("wrong error. expecting: %v (|%f - e| <= %e), receiving %v", nonlineareq.ErrMaxIter, d, errEst, err)
+	}
+	// Test case: an unreachable tolerance stops when the rounding error dominates, before maxIter halvings
+	if d, _, err = Richardson(math.Exp, 1, 1, 0, 1e-20, 30); err != nil || math.Abs(d-math.E) > 1e-12 {
+		t.Errorf("wrong estimation. expecting: %.15f, receiving %.15f (%v)", math.E, d, err)
+	}
+	if _, _, err = Richardson(math.Exp, 1, 0, 0, 1e-12, 10); err != ErrInvalidDerivative {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrInvalidDerivative, err)
+	}
+}
@@ -0,0 +1,137 @@
+// Package dual implements forward mode automatic differentiation with dual numbers a + b*e, where e*e = 0
+// Evaluating a function written with dual numbers at Var(x) returns f(x) + f'(x)*e, so the derivative is exact (up to
+// rounding) and does not need to be written by hand
+package dual
+
+// Dual is the dual number Real + Eps*e, where Eps carries the derivative of Real
+type Dual struct {
+	Real float64 // value
+	Eps  float64 // derivative
+}
+
+// Func function type is used to create y=f(x) type of functions of dual numbers
+type Func func(x Dual) Dual
+
+// ScalarFunc function type is used to create y=f(X) type of functions of several dual numbers (scalar fields)
+type ScalarFunc func(x []Dual) Dual
+
+// VecFunc function type is used to create Y=F(X) type of vector functions of dual numbers
+type VecFunc func(x []Dual) []Dual
+
+// Var returns the independent variable x, whose derivative is 1
+func Var(x float64) Dual {
+	return Dual{Real: x, Eps: 1}
+}
+
+// Const returns the constant c, whose derivative is 0
+func Const(c float64) Dual {
+	return Dual{Real: c}
+}
+
+// Add returns a + b
+func Add(a, b Dual) Dual {
+	return Dual{Real: a.Real + b.Real, Eps: a.Eps + b.Eps}
+}
+
+// Sub returns a - b
+func Sub(a, b Dual) Dual {
+	return Dual{Real: a.Real - b.Real, Eps: a.Eps - b.Eps}
+}
+
+// Mul returns a * b
+func Mul(a, b Dual) Dual {
+	return Dual{Real: a.Real * b.Real, Eps: a.Eps*b.Real + a.Real*b.Eps}
+}
+
+// Div returns a / b
+func Div(a, b Dual) Dual {
+	return Dual{Real: a.Real / b.Real, Eps: (a.Eps*b.Real - a.Real*b.Eps) / (b.Real * b.Real)}
+}
+
+// Neg returns -a
+func Neg(a Dual) Dual {
+	return Dual{Real: -a.Real, Eps: -a.Eps}
+}
+
+// Scale returns c * a for the real constant c
+func Scale(c float64, a Dual) Dual {
+	return Dual{Real: c * a.Real, Eps: c * a.Eps}
+}
+
+// Shift returns a + c for the real constant c
+func Shift(a Dual, c float64) Dual {
+	return Dual{Real: a.Real + c, Eps: a.Eps}
+}
+
+// Derivative evaluates the function f and its derivative at x
+// Input:
+// - f: function of a dual number
+// - x: point of evaluation
+// Output:
+// - fx: value f(x)
+// - dfx: derivative f'(x)
+func Derivative(f Func, x float64) (fx, dfx float64) {
+	y := f(Var(x))
+	return y.Real, y.Eps
+}
+
+// Gradient evaluates the scalar field f and its gradient at x, f is evaluated once for every component of x
+// Input:
+// - f: function of several dual numbers
+// - x: point of evaluation
+// Output:
+// - fx: value f(x)
+// - grad: gradient, where grad[j] = df/dx_j
+func Gradient(f ScalarFunc, x []float64) (fx float64, grad []float64) {
+	grad = make([]float64, len(x))
+	xd := constants(x)
+	fx = f(xd).Real
+	for j := range x {
+		xd[j].Eps = 1
+		grad[j] = f(xd).Eps
+		xd[j].Eps = 0
+	}
+	return fx, grad
+}
+
+// Jacobian evaluates the vector function f and its Jacobian matrix at x, f is evaluated once for every component of x
+// Input:
+// - f: vector function of several dual numbers
+// - x: point of evaluation
+// Output:
+// - fx: value F(x)
+// - jac: Jacobian matrix, where jac[i][j] = dF_i/dx_j
+func Jacobian(f VecFunc, x []float64) (fx []float64, jac [][]float64) {
+	xd := constants(x)
+	fx = Values(f(xd))
+	jac = make([][]float64, len(fx))
+	for i := range jac {
+		jac[i] = make([]float64, len(x))
+	}
+	for j := range x {
+		xd[j].Eps = 1
+		for i, y := range f(xd) {
+			jac[i][j] = y.Eps
+		}
+		xd[j].Eps = 0
+	}
+	return fx, jac
+}
+
+// Values returns the real parts of the dual numbers x
+func Values(x []Dual) (values []float64) {
+	values = make([]float64, len(x))
+	for i := range x {
+		values[i] = x[i].Real
+	}
+	return values
+}
+
+// constants converts x into dual numbers with zero derivative
+func constants(x []float64) (xd []Dual) {
+	xd = make([]Dual, len(x))
+	for i := range x {
+		xd[i] = Const(x[i])
+	}
+	return xd
+}
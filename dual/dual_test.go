@@ -0,0 +1,57 @@
+package dual
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDerivative(t *testing.T) {
+	// ex. 2.2 f(x) = x^3 + 4x^2 - 10, f'(x) = 3x^2 + 8x
+	f := func(x Dual) Dual {
+		return Shift(Add(Mul(Mul(x, x), x), Scale(4, Mul(x, x))), -10)
+	}
+	for _, x := range []float64{-2, 0, 1.5, 3} {
+		fx, dfx := Derivative(f, x)
+		if fx != x*x*x+4*x*x-10 || dfx != 3*x*x+8*x {
+			t.Errorf("wrong derivative at %f. expecting: %f, %f, receiving %f, %f", x, x*x*x+4*x*x-10, 3*x*x+8*x, fx, dfx)
+		}
+	}
+
+	// quotient and chain rules: f(x) = sin(x^2) / (1 + x), f'(x) = (2x cos(x^2)(1+x) - sin(x^2)) / (1+x)^2
+	f = func(x Dual) Dual {
+		return Div(Sin(Mul(x, x)), Shift(x, 1))
+	}
+	x := 0.9
+	_, dfx := Derivative(f, x)
+	expected := (2*x*math.Cos(x*x)*(1+x) - math.Sin(x*x)) / ((1 + x) * (1 + x))
+	if math.Abs(dfx-expected) > 1e-15 {
+		t.Errorf("wrong derivative. expecting: %.15f, receiving %.15f", expected, dfx)
+	}
+	if c := Sub(Neg(Const(2)), Var(1)); c.Real != -3 || c.Eps != -1 {
+		t.Errorf("wrong value. expecting: {-3 -1}, receiving %v", c)
+	}
+}
+
+func TestGradientJacobian(t *testing.T) {
+	// f(x, y) = x^2 y + exp(y)
+	f := func(x []Dual) Dual {
+		return Add(Mul(Mul(x[0], x[0]), x[1]), Exp(x[1]))
+	}
+	fx, grad := Gradient(f, []float64{2, 0})
+	if fx != 1 || !reflect.DeepEqual(grad, []float64{0, 5}) {
+		t.Errorf("wrong gradient. expecting: 1, [0 5], receiving %f, %v", fx, grad)
+	}
+
+	// F(x, y) = [x^2 - 2x - y + 0.5, x^2 + 4y^2 - 4]
+	g := func(x []Dual) []Dual {
+		return []Dual{
+			Shift(Sub(Sub(Mul(x[0], x[0]), Scale(2, x[0])), x[1]), 0.5),
+			Shift(Add(Mul(x[0], x[0]), Scale(4, Mul(x[1], x[1]))), -4),
+		}
+	}
+	gx, jac := Jacobian(g, []float64{2, 0.25})
+	if !reflect.DeepEqual(gx, []float64{0.25, 0.25}) || !reflect.DeepEqual(jac, [][]float64{{2, -1}, {4, 2}}) {
+		t.Errorf("wrong jacobian. expecting: [0.25 0.25], [[2 -1] [4 2]], receiving %v, %v", gx, jac)
+	}
+}
@@ -0,0 +1,113 @@
+package dual
+
+import "math"
+
+// Sqrt returns the square root of a
+// The derivative of a constant is 0 also at a = 0, where the derivative of the square root is not finite
+func Sqrt(a Dual) Dual {
+	s := math.Sqrt(a.Real)
+	if a.Eps == 0 {
+		return Dual{Real: s}
+	}
+	return Dual{Real: s, Eps: a.Eps / (2 * s)}
+}
+
+// Exp returns e**a
+func Exp(a Dual) Dual {
+	e := math.Exp(a.Real)
+	return Dual{Real: e, Eps: a.Eps * e}
+}
+
+// Log returns the natural logarithm of a
+func Log(a Dual) Dual {
+	if a.Eps == 0 {
+		return Dual{Real: math.Log(a.Real)}
+	}
+	return Dual{Real: math.Log(a.Real), Eps: a.Eps / a.Real}
+}
+
+// Pow returns a**b
+// If b is a constant the derivative is b*a**(b-1)*a', which is also valid for a < 0, otherwise the term a**b*log(a)*b'
+// is added. The terms are skipped when they vanish, so a**0 and 0**b (b > 0) have finite derivatives
+func Pow(a, b Dual) Dual {
+	p := math.Pow(a.Real, b.Real)
+	eps := 0.0
+	if a.Eps != 0 && b.Real != 0 {
+		eps = a.Eps * b.Real * math.Pow(a.Real, b.Real-1)
+	}
+	if b.Eps != 0 && p != 0 {
+		eps += b.Eps * p * math.Log(a.Real)
+	}
+	return Dual{Real: p, Eps: eps}
+}
+
+// PowConst returns a**n for the real constant n
+func PowConst(a Dual, n float64) Dual {
+	return Pow(a, Const(n))
+}
+
+// Abs returns the absolute value of a, the derivative at 0 is taken as 0
+func Abs(a Dual) Dual {
+	switch {
+	case a.Real > 0:
+		return a
+	case a.Real < 0:
+		return Neg(a)
+	}
+	return Dual{}
+}
+
+// Sin returns the sine of a
+func Sin(a Dual) Dual {
+	s, c := math.Sincos(a.Real)
+	return Dual{Real: s, Eps: a.Eps * c}
+}
+
+// Cos returns the cosine of a
+func Cos(a Dual) Dual {
+	s, c := math.Sincos(a.Real)
+	return Dual{Real: c, Eps: -a.Eps * s}
+}
+
+// Tan returns the tangent of a
+func Tan(a Dual) Dual {
+	t := math.Tan(a.Real)
+	return Dual{Real: t, Eps: a.Eps * (1 + t*t)}
+}
+
+// Asin returns the arcsine of a
+func Asin(a Dual) Dual {
+	if a.Eps == 0 {
+		return Dual{Real: math.Asin(a.Real)}
+	}
+	return Dual{Real: math.Asin(a.Real), Eps: a.Eps / math.Sqrt(1-a.Real*a.Real)}
+}
+
+// Acos returns the arccosine of a
+func Acos(a Dual) Dual {
+	if a.Eps == 0 {
+		return Dual{Real: math.Acos(a.Real)}
+	}
+	return Dual{Real: math.Acos(a.Real), Eps: -a.Eps / math.Sqrt(1-a.Real*a.Real)}
+}
+
+// Atan returns the arctangent of a
+func Atan(a Dual) Dual {
+	return Dual{Real: math.Atan(a.Real), Eps: a.Eps / (1 + a.Real*a.Real)}
+}
+
+// Sinh returns the hyperbolic sine of a
+func Sinh(a Dual) Dual {
+	return Dual{Real: math.Sinh(a.Real), Eps: a.Eps * math.Cosh(a.Real)}
+}
+
+// Cosh returns the hyperbolic cosine of a
+func Cosh(a Dual) Dual {
+	return Dual{Real: math.Cosh(a.Real), Eps: a.Eps * math.Sinh(a.Real)}
+}
+
+// Tanh returns the hyperbolic tangent of a
+func Tanh(a Dual) Dual {
+	t := math.Tanh(a.Real)
+	return Dual{Real: t, Eps: a.Eps * (1 - t*t)}
+}
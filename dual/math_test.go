@@ -0,0 +1,77 @@
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+type testStructElementary struct {
+	TestCaseName string
+	TestF        Func
+	TestDF       func(x float64) float64
+	X            float64
+}
+
+// TestElementary compares the derivatives of the elementary functions against their closed forms
+func TestElementary(t *testing.T) {
+	testCases := []testStructElementary{
+		{"sqrt", Sqrt, func(x float64) float64 { return 0.5 / math.Sqrt(x) }, 2},
+		{"exp", Exp, math.Exp, 0.7},
+		{"log", Log, func(x float64) float64 { return 1 / x }, 3},
+		{"pow const", func(x Dual) Dual { return PowConst(x, 3) }, func(x float64) float64 { return 3 * x * x }, -1.5},
+		{"pow var", func(x Dual) Dual { return Pow(x, x) }, func(x float64) float64 { return math.Pow(x, x) * (math.Log(x) + 1) }, 1.3},
+		{"abs", Abs, func(x float64) float64 { return -1 }, -2},
+		{"sin", Sin, math.Cos, 0.4},
+		{"cos", Cos, func(x float64) float64 { return -math.Sin(x) }, 0.4},
+		{"tan", Tan, func(x float64) float64 { return 1 / math.Pow(math.Cos(x), 2) }, 1.1},
+		{"asin", Asin, func(x float64) float64 { return 1 / math.Sqrt(1-x*x) }, 0.3},
+		{"acos", Acos, func(x float64) float64 { return -1 / math.Sqrt(1-x*x) }, 0.3},
+		{"atan", Atan, func(x float64) float64 { return 1 / (1 + x*x) }, 2},
+		{"sinh", Sinh, math.Cosh, 0.8},
+		{"cosh", Cosh, math.Sinh, 0.8},
+		{"tanh", Tanh, func(x float64) float64 { return 1 / math.Pow(math.Cosh(x), 2) }, 0.8},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		_, dfx := Derivative(tc.TestF, tc.X)
+		if expected := tc.TestDF(tc.X); math.Abs(dfx-expected) > 1e-14*math.Max(math.Abs(expected), 1) {
+			t.Errorf("wrong derivative for case: %s. expecting: %.15f, receiving %.15f", tc.TestCaseName, expected, dfx)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
+
+// TestSingularPoints checks that the derivatives are not poisoned by the points where the closed forms are not finite
+func TestSingularPoints(t *testing.T) {
+	testCases := []struct {
+		TestCaseName string
+		TestValue    Dual
+		ExpectedEps  float64
+	}{
+		{"sqrt of a constant 0", Sqrt(Const(0)), 0},
+		{"log of a constant 0", Log(Const(0)), 0},
+		{"asin of a constant 1", Asin(Const(1)), 0},
+		{"acos of a constant -1", Acos(Const(-1)), 0},
+		{"x**0 at 0", PowConst(Var(0), 0), 0},
+		{"0**b", Pow(Const(0), Var(2)), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		if tc.TestValue.Eps != tc.ExpectedEps {
+			t.Errorf("wrong derivative for case: %s. expecting: %f, receiving %f", tc.TestCaseName, tc.ExpectedEps, tc.TestValue.Eps)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	// Test case: the Jacobian of sqrt(x0^2) + x1 at (0, 1), the x1 column is not poisoned by the constant sqrt(0)
+	t.Logf("testing case number: jacobian at a singular point")
+	f := func(x []Dual) []Dual {
+		return []Dual{Add(Sqrt(Mul(x[0], x[0])), x[1])}
+	}
+	if _, jac := Jacobian(f, []float64{0, 1}); jac[0][0] != 0 || jac[0][1] != 1 {
+		t.Errorf("wrong jacobian. expecting: [[0 1]], receiving %v", jac)
+	}
+	t.Logf("testing case number: jacobian at a singular point OK")
+}
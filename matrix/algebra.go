@@ -3,6 +3,7 @@ package matrix
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"golang.org/x/exp/constraints"
 )
@@ -127,6 +128,75 @@ func MatrixMult[Num Number](a, b [][]Num) (resVal [][]Num, err error) {
 	return
 }
 
+// MatrixVectMult implements the multiplication of a matrix and a column vector
+// Input:
+// a is a matrix of the form [rows][column]Matrix
+// vect is a vector of the form []Vector with as many components as columns in a
+// Output:
+// resVal is the vector a * vect
+func MatrixVectMult[Num Number](a [][]Num, vect []Num) (resVal []Num, err error) {
+	resVal = make([]Num, len(a))
+	for i := range a {
+		resVal[i], err = VectScalMult(a[i], vect)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resVal, nil
+}
+
+// VectOuterProd implements the outer product of two vectors
+// Input:
+// a, and b are two vectors of the form []Vector
+// Output:
+// resVal is the matrix a * transpose(b) of size [len(a)][len(b)]
+func VectOuterProd[Num Number](a, b []Num) (resVal [][]Num) {
+	resVal = make([][]Num, len(a))
+	for i := range a {
+		resVal[i] = make([]Num, len(b))
+		for j := range b {
+			resVal[i][j] = a[i] * b[j]
+		}
+	}
+	return
+}
+
+// NormType selects the vector norm computed by VectNorm
+type NormType int
+
+const (
+	NormOne NormType = iota + 1 // sum of the absolute values of the components
+	NormTwo                     // euclidean norm
+	NormInf                     // maximum absolute value of the components
+)
+
+// VectNorm estimates the norm of a vector
+// Input:
+// vect is a vector of the form []Vector
+// norm is the type of norm (NormOne, NormTwo or NormInf), any other value defaults to NormTwo
+// Output:
+// resVal is the norm of the vector
+func VectNorm[Num constraints.Float](vect []Num, norm NormType) (resVal Num) {
+	switch norm {
+	case NormOne:
+		for _, v := range vect {
+			resVal += abs(v)
+		}
+	case NormInf:
+		for _, v := range vect {
+			if abs(v) > resVal || v != v {
+				resVal = abs(v)
+			}
+		}
+	default:
+		for _, v := range vect {
+			resVal += v * v
+		}
+		resVal = Num(math.Sqrt(float64(resVal)))
+	}
+	return
+}
+
 // VectScalMult implements the scalar multiplication of vectors
 // Input:
 // a, and b are two equaly sized (equal number of components) vectors of the form []Vector
@@ -396,3 +396,94 @@ func TestMatrixSq(t *testing.T) {
 		}
 	}
 }
+
+type testVectNorm struct {
+	TestVectF64 []float64
+	TestVectF32 []float32
+	ExpectedOne float64
+	ExpectedTwo float64
+	ExpectedInf float64
+}
+
+func TestVectNorm(t *testing.T) {
+	testCases := make([]testVectNorm, 2)
+	testCases[0].TestVectF64 = []float64{3, -4}
+	testCases[0].TestVectF32 = []float32{3, -4}
+	testCases[0].ExpectedOne = 7
+	testCases[0].ExpectedTwo = 5
+	testCases[0].ExpectedInf = 4
+	testCases[1].TestVectF64 = []float64{-1, 2, -2, 0}
+	testCases[1].TestVectF32 = []float32{-1, 2, -2, 0}
+	testCases[1].ExpectedOne = 5
+	testCases[1].ExpectedTwo = 3
+	testCases[1].ExpectedInf = 2
+	for _, tc := range testCases {
+		if res := VectNorm(tc.TestVectF64, NormOne); res != tc.ExpectedOne {
+			t.Errorf("wrong 1-norm for float64 type. Expected: %v, received: %v", tc.ExpectedOne, res)
+		}
+		if res := VectNorm(tc.TestVectF64, NormTwo); res != tc.ExpectedTwo {
+			t.Errorf("wrong 2-norm for float64 type. Expected: %v, received: %v", tc.ExpectedTwo, res)
+		}
+		if res := VectNorm(tc.TestVectF64, NormInf); res != tc.ExpectedInf {
+			t.Errorf("wrong infinity norm for float64 type. Expected: %v, received: %v", tc.ExpectedInf, res)
+		}
+		if res := VectNorm(tc.TestVectF32, NormOne); float64(res) != tc.ExpectedOne {
+			t.Errorf("wrong 1-norm for float32 type. Expected: %v, received: %v", tc.ExpectedOne, res)
+		}
+		if res := VectNorm(tc.TestVectF32, NormTwo); float64(res) != tc.ExpectedTwo {
+			t.Errorf("wrong 2-norm for float32 type. Expected: %v, received: %v", tc.ExpectedTwo, res)
+		}
+		if res := VectNorm(tc.TestVectF32, NormInf); float64(res) != tc.ExpectedInf {
+			t.Errorf("wrong infinity norm for float32 type. Expected: %v, received: %v", tc.ExpectedInf, res)
+		}
+	}
+}
+
+type testMatrixVectMult struct {
+	TestMatrixInt     [][]int
+	TestVectInt       []int
+	ExpectedVectInt   []int
+	TestVectBInt      []int
+	ExpectedOuterInt  [][]int
+	TestExpectedError error
+}
+
+func TestMatrixVectMult(t *testing.T) {
+	testCases := make([]testMatrixVectMult, 2)
+	testCases[0].TestMatrixInt = [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	testCases[0].TestVectInt = []int{1, 0, -1}
+	testCases[0].ExpectedVectInt = []int{-2, -2}
+	testCases[0].TestVectBInt = []int{2, 3}
+	testCases[0].ExpectedOuterInt = [][]int{
+		{2, 3},
+		{0, 0},
+		{-2, -3},
+	}
+	testCases[1].TestMatrixInt = [][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	testCases[1].TestVectInt = []int{1, 0}
+	testCases[1].TestVectBInt = []int{2}
+	testCases[1].ExpectedOuterInt = [][]int{
+		{2},
+		{0},
+	}
+	testCases[1].TestExpectedError = ErrVecSizeMissmatch
+	for _, tc := range testCases {
+		res, err := MatrixVectMult(tc.TestMatrixInt, tc.TestVectInt)
+		if err != tc.TestExpectedError {
+			t.Errorf("fail to catch expected error int type. Expected: %v, received: %v", tc.TestExpectedError, err)
+		}
+		if !reflect.DeepEqual(res, tc.ExpectedVectInt) {
+			t.Errorf("wrong result value, int variable type. Expected: %v, received: %v", tc.ExpectedVectInt, res)
+		}
+		outer := VectOuterProd(tc.TestVectInt, tc.TestVectBInt)
+		if !reflect.DeepEqual(outer, tc.ExpectedOuterInt) {
+			t.Errorf("wrong outer product, int variable type. Expected: %v, received: %v", tc.ExpectedOuterInt, outer)
+		}
+	}
+}
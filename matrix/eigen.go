@@ -0,0 +1,266 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrEigNotConverged = errors.New("the eigenvalue iteration did not converge")
+
+// eigMaxIter is the maximum number of QR iterations to isolate each eigenvalue
+const eigMaxIter = 30
+
+// Eigenvalues estimates the (complex) eigenvalues of a real square matrix
+// The matrix is balanced, reduced to the upper Hessenberg form with Householder reflections and then the eigenvalues are
+// isolated with the shifted QR algorithm (Francis double shift), so the complex eigenvalues come in conjugate pairs
+// The reduction and the QR algorithm follow G. H. Golub and C. F. Van Loan, Matrix Computations (4th ed., 2013),
+// algorithms 7.4.2 and 7.5.2, and the balancing follows the LAPACK routine dgebal
+// The input matrix is not modified
+// Input:
+// a is a square matrix of the form [rows][column]Matrix
+// Output:
+// eig are the eigenvalues of a, in no particular order (none for an empty matrix)
+func Eigenvalues[Num constraints.Float](a [][]Num) (eig []complex128, err error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	checkSquare, size := IsSquare(a)
+	if !checkSquare {
+		return nil, ErrMatNotSquare
+	}
+	n := size[0]
+	h := make([][]float64, n)
+	for i := range h {
+		h[i] = make([]float64, n)
+		for j := range h[i] {
+			h[i][j] = float64(a[i][j])
+		}
+	}
+	balance(h)
+	hessenberg(h)
+	return hessenbergQR(h)
+}
+
+// balance applies (in place) the similarity transformation D^-1 a D, where D is a diagonal matrix of powers of 2 that
+// makes the euclidean norms of every row and its column similar. The powers of 2 do not add rounding errors, and the
+// smaller norm of the balanced matrix reduces the rounding errors of the eigenvalues
+// R. James, J. Langou and B. R. Lowery, On matrix balancing and eigenvector computation (2014), as in LAPACK dgebal
+func balance(a [][]float64) {
+	const radix = 2.0
+	for converged := false; !converged; {
+		converged = true
+		for i := range a {
+			c, r := 0.0, 0.0
+			for j := range a {
+				c = math.Hypot(c, a[j][i])
+				r = math.Hypot(r, a[i][j])
+			}
+			if c == 0 || r == 0 || math.IsInf(c+r, 0) || math.IsNaN(c+r) {
+				continue
+			}
+			// f is the power of 2 that brings c*f and r/f closest
+			norm := c + r
+			f := 1.0
+			for c < r/radix {
+				f *= radix
+				c *= radix
+				r /= radix
+			}
+			for c/radix >= r {
+				f /= radix
+				c /= radix
+				r *= radix
+			}
+			if c+r >= 0.95*norm {
+				continue
+			}
+			converged = false
+			for j := range a {
+				a[i][j] /= f
+				a[j][i] *= f
+			}
+		}
+	}
+}
+
+// hessenberg reduces a (in place) to the upper Hessenberg form with the similarity transformations of the Householder
+// reflections P = I - beta v v^T that zero each column below the subdiagonal (Golub and Van Loan, algorithm 7.4.2)
+func hessenberg(a [][]float64) {
+	n := len(a)
+	v := make([]float64, n)
+	x := make([]float64, n)
+	for k := 0; k < n-2; k++ {
+		for i := k + 1; i < n; i++ {
+			x[i] = a[i][k]
+		}
+		beta := vectorReflection(v[k+1:n], x[k+1:n]...)
+		if beta == 0 {
+			continue
+		}
+		reflectRows(a, v[k+1:n], beta, k+1, k, n-1)
+		reflectCols(a, v[k+1:n], beta, k+1, 0, n-1)
+		// the column is reflected to alpha e1, where alpha = x[k+1] - v[k+1]
+		a[k+1][k] = x[k+1] - v[k+1]
+		for i := k + 2; i < n; i++ {
+			a[i][k] = 0
+		}
+	}
+}
+
+// reflectRows applies P = I - beta v v^T from the left to the rows row to row+len(v)-1 and the columns c0 to c1 of a
+func reflectRows(a [][]float64, v []float64, beta float64, row, c0, c1 int) {
+	for j := c0; j <= c1; j++ {
+		dot := 0.0
+		for i := range v {
+			dot += v[i] * a[row+i][j]
+		}
+		dot *= beta
+		for i := range v {
+			a[row+i][j] -= dot * v[i]
+		}
+	}
+}
+
+// reflectCols applies P = I - beta v v^T from the right to the rows r0 to r1 and the columns col to col+len(v)-1 of a
+func reflectCols(a [][]float64, v []float64, beta float64, col, r0, r1 int) {
+	for i := r0; i <= r1; i++ {
+		dot := 0.0
+		for j := range v {
+			dot += a[i][col+j] * v[j]
+		}
+		dot *= beta
+		for j := range v {
+			a[i][col+j] -= dot * v[j]
+		}
+	}
+}
+
+// hessenbergQR returns the eigenvalues of the upper Hessenberg matrix a with the Francis double shift QR algorithm, a is
+// destroyed
+// The active block [lo, hi] is delimited by the negligible subdiagonal elements. Its last 1x1 or 2x2 diagonal block gives
+// one or two eigenvalues when it splits from the rest, otherwise a Francis step (Golub and Van Loan, algorithm 7.5.1) with
+// the eigenvalues of the last 2x2 block as shifts is applied to the active block
+func hessenbergQR(a [][]float64) (eig []complex128, err error) {
+	n := len(a)
+	eig = make([]complex128, 0, n)
+	eps := math.Nextafter(1, 2) - 1
+	norm := 0.0
+	for i := range a {
+		for j := range a[i] {
+			norm = math.Hypot(norm, a[i][j])
+		}
+	}
+	its := 0
+	for hi := n - 1; hi >= 0; {
+		// Looking for the start of the active block
+		lo := hi
+		for ; lo > 0; lo-- {
+			s := math.Abs(a[lo-1][lo-1]) + math.Abs(a[lo][lo])
+			if s == 0 {
+				s = norm
+			}
+			if math.Abs(a[lo][lo-1]) <= eps*s {
+				a[lo][lo-1] = 0
+				break
+			}
+		}
+		switch {
+		case lo == hi:
+			eig = append(eig, complex(a[hi][hi], 0))
+			hi--
+			its = 0
+			continue
+		case lo == hi-1:
+			l1, l2 := eig2x2(a[hi-1][hi-1], a[hi-1][hi], a[hi][hi-1], a[hi][hi])
+			eig = append(eig, l1, l2)
+			hi -= 2
+			its = 0
+			continue
+		case its == eigMaxIter:
+			return nil, ErrEigNotConverged
+		}
+		its++
+		// s and t are the sum and the product of the shifts
+		s := a[hi-1][hi-1] + a[hi][hi]
+		t := a[hi-1][hi-1]*a[hi][hi] - a[hi-1][hi]*a[hi][hi-1]
+		if its%10 == 0 {
+			// exceptional shifts break the cycles of the iteration
+			w := math.Abs(a[hi][hi-1]) + math.Abs(a[hi-1][hi-2])
+			d := a[hi][hi] + 0.75*w
+			s = 2 * d
+			t = d*d + 0.4375*w*w
+		}
+		francisStep(a, lo, hi, s, t)
+	}
+	return eig, nil
+}
+
+// francisStep applies the implicit double shift QR step to the active block [lo, hi] (at least 3x3) of the Hessenberg
+// matrix a, where s and t are the sum and the product of the shifts. The first column of (a - s1)(a - s2) defines the
+// first reflection, and the following reflections chase the bulge down the subdiagonal
+func francisStep(a [][]float64, lo, hi int, s, t float64) {
+	v := make([]float64, len(a))
+	x := a[lo][lo]*a[lo][lo] + a[lo][lo+1]*a[lo+1][lo] - s*a[lo][lo] + t
+	y := a[lo+1][lo] * (a[lo][lo] + a[lo+1][lo+1] - s)
+	z := a[lo+1][lo] * a[lo+2][lo+1]
+	for k := lo; k <= hi-2; k++ {
+		beta := vectorReflection(v[k:k+3], x, y, z)
+		if beta != 0 {
+			q := max(k-1, lo)
+			reflectRows(a, v[k:k+3], beta, k, q, hi)
+			reflectCols(a, v[k:k+3], beta, k, lo, min(k+3, hi))
+			if k > lo {
+				// the bulge is moved one column down
+				a[k+1][k-1] = 0
+				a[k+2][k-1] = 0
+			}
+		}
+		x = a[k+1][k]
+		y = a[k+2][k]
+		if k < hi-2 {
+			z = a[k+3][k]
+		}
+	}
+	if beta := vectorReflection(v[hi-1:hi+1], x, y); beta != 0 {
+		reflectRows(a, v[hi-1:hi+1], beta, hi-1, hi-2, hi)
+		reflectCols(a, v[hi-1:hi+1], beta, hi-1, lo, hi)
+		a[hi][hi-2] = 0
+	}
+}
+
+// vectorReflection sets v to the Householder vector of x and returns beta, 0 if x is zero. The reflection maps x to
+// alpha e1 with alpha = -sign(x[0]) ||x||, which avoids the cancellation in v[0] = x[0] - alpha
+func vectorReflection(v []float64, x ...float64) (beta float64) {
+	norm := 0.0
+	for i := range v {
+		v[i] = x[i]
+		norm = math.Hypot(norm, x[i])
+	}
+	if norm == 0 {
+		return 0
+	}
+	v[0] += math.Copysign(norm, v[0])
+	vv := 0.0
+	for i := range v {
+		vv += v[i] * v[i]
+	}
+	return 2 / vv
+}
+
+// eig2x2 returns the eigenvalues of the 2x2 matrix [[a, b], [c, d]], the real eigenvalues are computed without
+// cancellation and the complex ones are a conjugate pair
+func eig2x2(a, b, c, d float64) (l1, l2 complex128) {
+	p := 0.5 * (a - d)
+	disc := p*p + b*c
+	if disc < 0 {
+		im := math.Sqrt(-disc)
+		return complex(d+p, im), complex(d+p, -im)
+	}
+	z := p + math.Copysign(math.Sqrt(disc), p)
+	if z == 0 {
+		return complex(d, 0), complex(d, 0)
+	}
+	return complex(d+z, 0), complex(d-b*c/z, 0)
+}
@@ -0,0 +1,124 @@
+package matrix
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+)
+
+type testStructEigen struct {
+	TestCaseName  string
+	TestMatrix    [][]float64
+	Expected      []complex128
+	ExpectedError error
+}
+
+// sortComplex sorts complex numbers by real part and then by imaginary part
+func sortComplex(z []complex128) {
+	sort.Slice(z, func(i, j int) bool {
+		if real(z[i]) != real(z[j]) {
+			return real(z[i]) < real(z[j])
+		}
+		return imag(z[i]) < imag(z[j])
+	})
+}
+
+func TestEigenvalues(t *testing.T) {
+	testCases := []testStructEigen{
+		{
+			TestCaseName: "diagonal",
+			TestMatrix:   [][]float64{{3, 0, 0}, {0, -1, 0}, {0, 0, 2}},
+			Expected:     []complex128{-1, 2, 3},
+		},
+		{
+			TestCaseName: "symmetric",
+			TestMatrix:   [][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}},
+			Expected:     []complex128{complex(2-math.Sqrt2, 0), 2, complex(2+math.Sqrt2, 0)},
+		},
+		{
+			TestCaseName: "rotation",
+			TestMatrix:   [][]float64{{0, -1}, {1, 0}},
+			Expected:     []complex128{-1i, 1i},
+		},
+		{
+			// companion matrix of (x - 1)(x - 2)(x^2 + 1) = x^4 - 3x^3 + 3x^2 - 3x + 2
+			TestCaseName: "companion",
+			TestMatrix:   [][]float64{{3, -3, 3, -2}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}},
+			Expected:     []complex128{-1i, 1i, 1, 2},
+		},
+		{
+			// diag(1, 1e-6, 1e-12) scales the symmetric matrix [[1, 1, 0], [1, 2, 1], [0, 1, 3]], balancing removes the scaling
+			TestCaseName: "badly scaled",
+			TestMatrix:   [][]float64{{1, 1e6, 0}, {1e-6, 2, 1e6}, {0, 1e-6, 3}},
+			Expected:     []complex128{complex(2-math.Sqrt(3), 0), 2, complex(2+math.Sqrt(3), 0)},
+		},
+		{
+			// the subdiagonal splits the matrix into two blocks, which are solved separately
+			TestCaseName: "reducible",
+			TestMatrix:   [][]float64{{1, 2, 3, 4}, {-2, 1, 5, 6}, {0, 0, 4, 1}, {0, 0, 0, 3}},
+			Expected:     []complex128{1 - 2i, 1 + 2i, 3, 4},
+		},
+		{
+			TestCaseName: "general 5x5",
+			TestMatrix: [][]float64{
+				{4, 1, -2, 2, 0},
+				{1, 2, 0, 1, 3},
+				{-2, 0, 3, -2, 1},
+				{2, 1, -2, -1, 0},
+				{0, 3, 1, 0, 1},
+			},
+		},
+		{
+			TestCaseName: "empty",
+			TestMatrix:   [][]float64{},
+		},
+		{
+			TestCaseName:  "non square",
+			TestMatrix:    [][]float64{{1, 2, 3}, {4, 5, 6}},
+			ExpectedError: ErrMatNotSquare,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		eig, err := Eigenvalues(tc.TestMatrix)
+		if err != tc.ExpectedError {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedError, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(eig) != len(tc.TestMatrix) {
+			t.Errorf("wrong number of eigenvalues for case: %s. expecting: %d, receiving %d", tc.TestCaseName, len(tc.TestMatrix), len(eig))
+			continue
+		}
+		if len(eig) == 0 {
+			t.Logf("testing case number: %s OK", tc.TestCaseName)
+			continue
+		}
+		if tc.Expected != nil {
+			sortComplex(eig)
+			for k := range eig {
+				if cmplx.Abs(eig[k]-tc.Expected[k]) > 1e-12 {
+					t.Errorf("wrong eigenvalues for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, eig)
+					break
+				}
+			}
+		}
+		// Test case: the trace and the determinant are the sum and the product of the eigenvalues
+		sum, prod := complex128(0), complex128(1)
+		trace := 0.0
+		for k := range eig {
+			sum += eig[k]
+			prod *= eig[k]
+			trace += tc.TestMatrix[k][k]
+		}
+		det, _ := MatrixDetReal(tc.TestMatrix)
+		if cmplx.Abs(sum-complex(trace, 0)) > 1e-10 || cmplx.Abs(prod-complex(det, 0)) > 1e-9*math.Max(math.Abs(det), 1) {
+			t.Errorf("wrong eigenvalues for case: %s. trace %f, determinant %f, receiving %v", tc.TestCaseName, trace, det, eig)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
@@ -0,0 +1,97 @@
+package matrix
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// MatrixSolve solves the linear system of equations a * x = b using Gaussian elimination with partial pivoting
+// The input matrix and vector are not modified
+// Input:
+// a is a square matrix of the form [rows][column]Matrix
+// b is the right hand side vector of the form []Vector
+// Output:
+// x is the solution vector
+func MatrixSolve[Num constraints.Float](a [][]Num, b []Num) (x []Num, err error) {
+	checkSquare, size := IsSquare(a)
+	if !checkSquare {
+		return nil, ErrMatNotSquare
+	}
+	n := size[0]
+	if len(b) != n {
+		return nil, ErrVecSizeMissmatch
+	}
+	// Augmented matrix [a|b]
+	aug := make([][]Num, n)
+	for i := 0; i < n; i++ {
+		aug[i] = make([]Num, n+1)
+		copy(aug[i], a[i])
+		aug[i][n] = b[i]
+	}
+	for k := 0; k < n; k++ {
+		// Finding the row with the largest pivot
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if abs(aug[i][k]) > abs(aug[pivot][k]) {
+				pivot = i
+			}
+		}
+		if aug[pivot][k] == 0 {
+			return nil, ErrMatSingular
+		}
+		aug[k], aug[pivot] = aug[pivot], aug[k]
+		for i := k + 1; i < n; i++ {
+			factor := aug[i][k] / aug[k][k]
+			for j := k; j <= n; j++ {
+				aug[i][j] -= factor * aug[k][j]
+			}
+		}
+	}
+	// Back substitution
+	x = make([]Num, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := aug[i][n]
+		for j := i + 1; j < n; j++ {
+			sum -= aug[i][j] * x[j]
+		}
+		x[i] = sum / aug[i][i]
+	}
+	return x, nil
+}
+
+// MatrixInverse estimates the inverse of a square matrix solving a * x = e(j) for each column e(j) of the identity matrix
+// Input:
+// a is a square matrix of the form [rows][column]Matrix
+// Output:
+// inv is the inverse matrix of a
+func MatrixInverse[Num constraints.Float](a [][]Num) (inv [][]Num, err error) {
+	checkSquare, size := IsSquare(a)
+	if !checkSquare {
+		return nil, ErrMatNotSquare
+	}
+	n := size[0]
+	inv = make([][]Num, n)
+	for i := range inv {
+		inv[i] = make([]Num, n)
+	}
+	unit := make([]Num, n)
+	for j := 0; j < n; j++ {
+		unit[j] = 1
+		col, err := MatrixSolve(a, unit)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < n; i++ {
+			inv[i][j] = col[i]
+		}
+		unit[j] = 0
+	}
+	return inv, nil
+}
+
+// abs returns the absolute value of a real number
+func abs[Num Real](x Num) Num {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
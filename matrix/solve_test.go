@@ -0,0 +1,136 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+type testStrsSolve struct {
+	TestMatrF64   [][]float64
+	TestVectF64   []float64
+	ExpSolF64     []float64
+	TestMatrF32   [][]float32
+	TestVectF32   []float32
+	ExpSolF32     []float32
+	ExpectedError error
+}
+
+func TestMatrixSolve(t *testing.T) {
+	testCase := make([]testStrsSolve, 4)
+	// Test cases: success (requires row swapping)
+	testCase[0].TestMatrF64 = [][]float64{
+		{0, 2, 1},
+		{1, -2, -3},
+		{-1, 1, 2},
+	}
+	testCase[0].TestVectF64 = []float64{-8, 0, 3}
+	testCase[0].ExpSolF64 = []float64{-4, -5, 2}
+	testCase[0].TestMatrF32 = [][]float32{
+		{0, 2, 1},
+		{1, -2, -3},
+		{-1, 1, 2},
+	}
+	testCase[0].TestVectF32 = []float32{-8, 0, 3}
+	testCase[0].ExpSolF32 = []float32{-4, -5, 2}
+	// Test cases: success
+	testCase[1].TestMatrF64 = [][]float64{
+		{2, 1, -1, 1},
+		{1, 1, 0, 3},
+		{-1, 2, 3, -1},
+		{3, -1, -1, 2},
+	}
+	testCase[1].TestVectF64 = []float64{3, 5, 3, 3}
+	testCase[1].ExpSolF64 = []float64{1, 1, 1, 1}
+	testCase[1].TestMatrF32 = [][]float32{
+		{2, 1, -1, 1},
+		{1, 1, 0, 3},
+		{-1, 2, 3, -1},
+		{3, -1, -1, 2},
+	}
+	testCase[1].TestVectF32 = []float32{3, 5, 3, 3}
+	testCase[1].ExpSolF32 = []float32{1, 1, 1, 1}
+	// Test cases: fail - not an invertible matrix (singular matrix)
+	testCase[2].TestMatrF64 = [][]float64{
+		{1, 2},
+		{2, 4},
+	}
+	testCase[2].TestVectF64 = []float64{1, 2}
+	testCase[2].TestMatrF32 = [][]float32{
+		{1, 2},
+		{2, 4},
+	}
+	testCase[2].TestVectF32 = []float32{1, 2}
+	testCase[2].ExpectedError = ErrMatSingular
+	// Test cases: fail - vector size missmatch
+	testCase[3].TestMatrF64 = [][]float64{
+		{1, 2},
+		{3, 4},
+	}
+	testCase[3].TestVectF64 = []float64{1, 2, 3}
+	testCase[3].TestMatrF32 = [][]float32{
+		{1, 2},
+		{3, 4},
+	}
+	testCase[3].TestVectF32 = []float32{1, 2, 3}
+	testCase[3].ExpectedError = ErrVecSizeMissmatch
+
+	for _, tc := range testCase {
+		solF64, err := MatrixSolve(tc.TestMatrF64, tc.TestVectF64)
+		if !errors.Is(err, tc.ExpectedError) {
+			t.Errorf("failed to detect error, float64 variable type. Expected: %v, received: %v", tc.ExpectedError, err)
+		}
+		for i := range tc.ExpSolF64 {
+			if math.Abs(solF64[i]-tc.ExpSolF64[i]) > 1e-12 {
+				t.Errorf("wrong result value, float64 variable type. Expected: %v, received: %v", tc.ExpSolF64, solF64)
+				break
+			}
+		}
+		solF32, err := MatrixSolve(tc.TestMatrF32, tc.TestVectF32)
+		if !errors.Is(err, tc.ExpectedError) {
+			t.Errorf("failed to detect error, float32 variable type. Expected: %v, received: %v", tc.ExpectedError, err)
+		}
+		for i := range tc.ExpSolF32 {
+			if math.Abs(float64(solF32[i]-tc.ExpSolF32[i])) > 1e-5 {
+				t.Errorf("wrong result value, float32 variable type. Expected: %v, received: %v", tc.ExpSolF32, solF32)
+				break
+			}
+		}
+	}
+	// Test case: the input matrix is not modified
+	if testCase[0].TestMatrF64[0][0] != 0 || testCase[0].TestVectF64[0] != -8 {
+		t.Errorf("input matrix modified")
+	}
+}
+
+func TestMatrixInverse(t *testing.T) {
+	testCase := make([]testStrsSolve, 2)
+	// Test cases: success
+	testCase[0].TestMatrF64 = [][]float64{
+		{4, 7},
+		{2, 6},
+	}
+	testCase[0].ExpSolF64 = []float64{0.6, -0.7, -0.2, 0.4}
+	// Test cases: fail - not an invertible matrix (singular matrix)
+	testCase[1].TestMatrF64 = [][]float64{
+		{1, 2},
+		{2, 4},
+	}
+	testCase[1].ExpectedError = ErrMatSingular
+
+	for _, tc := range testCase {
+		inv, err := MatrixInverse(tc.TestMatrF64)
+		if !errors.Is(err, tc.ExpectedError) {
+			t.Errorf("failed to detect error, float64 variable type. Expected: %v, received: %v", tc.ExpectedError, err)
+		}
+		if err == nil {
+			for i := range inv {
+				for j := range inv[i] {
+					if math.Abs(inv[i][j]-tc.ExpSolF64[i*len(inv)+j]) > 1e-12 {
+						t.Errorf("wrong result value, float64 variable type. Expected: %v, received: %v", tc.ExpSolF64, inv)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package nonlineareq
+
+import (
+	"context"
+	"math"
+
+	"github.com/gonzalochief/NumericAll/dual"
+)
+
+// FromDual returns the function f and its exact derivative, computed with automatic differentiation, so they can be used
+// by any of the methods that need dy (e.g. NewtonBisect or NewtonFinder)
+// The last evaluation is cached, so y(x) followed by dy(x) evaluates f only once. The returned functions must not be
+// used concurrently
+func FromDual(f dual.Func) (y, dy YEqFuncx) {
+	lastX := math.NaN()
+	var fx, dfx float64
+	eval := func(x float64) {
+		if x != lastX {
+			fx, dfx = dual.Derivative(f, x)
+			lastX = x
+		}
+	}
+	y = func(x float64) float64 {
+		eval(x)
+		return fx
+	}
+	dy = func(x float64) float64 {
+		eval(x)
+		return dfx
+	}
+	return y, dy
+}
+
+// FromDualVec returns the vector function f and its exact Jacobian matrix, computed with automatic differentiation, so
+// they can be used by NewtonSystem
+func FromDualVec(f dual.VecFunc) (fx VecFuncx, jac JacFuncx) {
+	fx = func(x []float64) []float64 {
+		xd := make([]dual.Dual, len(x))
+		for i := range x {
+			xd[i] = dual.Const(x[i])
+		}
+		return dual.Values(f(xd))
+	}
+	jac = func(x []float64) [][]float64 {
+		_, j := dual.Jacobian(f, x)
+		return j
+	}
+	return fx, jac
+}
+
+// NewtonAD estimates the value of x that makes the function equal to 0 using the Newton-Raphson Method, where the
+// derivative is computed exactly with automatic differentiation instead of being written by hand
+// Inputs:
+//
+//		y is the function function y=f(x) written with dual numbers
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func NewtonAD(y dual.Func, p0, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, i int, err error) {
+	return NewtonADCtx(context.Background(), y, p0, delta, epsilon, maxIter, 0)
+}
+
+// NewtonADCtx is like NewtonAD but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after
+// maxEval evaluations of the function and its derivative (0 for no limit)
+func NewtonADCtx(ctx context.Context, y dual.Func, p0, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	fx, dfx := FromDual(y)
+	return NewtonRaphsonCtx(ctx, fx, dfx, p0, delta, epsilon, maxIter, maxEval)
+}
+
+// NewtonSystemAD estimates the value of X that makes the vector function equal to 0 using the Newton-Raphson Method for
+// systems of nonlinear equations, where the Jacobian matrix is computed exactly with automatic differentiation
+// Inputs:
+//
+//		f is the vector function Y=F(X) written with dual numbers
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for ||F(P)||
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P)
+//	yZero is the function value evaluated at P
+//	absErr is the error of the approximation (euclidean norm of the last step)
+//	i is the iteration that generated the approximation
+func NewtonSystemAD(f dual.VecFunc, p0 []float64, delta, epsilon float64, maxIter int) (zeroApr, yZero []float64, absErr float64, i int, err error) {
+	return NewtonSystemADCtx(context.Background(), f, p0, delta, epsilon, maxIter, 0)
+}
+
+// NewtonSystemADCtx is like NewtonSystemAD but checks ctx before every evaluation (see FindSystemRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function and its Jacobian matrix (0 for no limit)
+func NewtonSystemADCtx(ctx context.Context, f dual.VecFunc, p0 []float64, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero []float64, absErr float64, i int, err error) {
+	fx, jac := FromDualVec(f)
+	return NewtonSystemCtx(ctx, fx, jac, p0, delta, epsilon, maxIter, maxEval)
+}
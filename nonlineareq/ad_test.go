@@ -0,0 +1,100 @@
+package nonlineareq
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/dual"
+)
+
+func TestNewtonAD(t *testing.T) {
+	// ex. 2.2 x^3 + 4x^2 - 10 = 0, the results must match NewtonRaphson with the hand written derivative
+	yD := func(x dual.Dual) dual.Dual {
+		return dual.Shift(dual.Add(dual.PowConst(x, 3), dual.Scale(4, dual.Mul(x, x))), -10)
+	}
+	y := func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	dy := func(x float64) float64 {
+		return 3*math.Pow(x, 2) + 8*x
+	}
+
+	t.Logf("testing case number: ex. 2.2")
+	p, yP, _, i, err := NewtonRaphson(y, dy, 1.5, 1e-12, 1e-12, 50)
+	pAD, yPAD, _, iAD, errAD := NewtonAD(yD, 1.5, 1e-12, 1e-12, 50)
+	if errAD != err || pAD != p || yPAD != yP || iAD != i {
+		t.Errorf("wrong estimation for case: ex. 2.2. expecting: %f (iteration %d), receiving %f (iteration %d)", p, i, pAD, iAD)
+	}
+	t.Logf("testing case number: ex. 2.2 OK")
+
+	t.Logf("testing case number: x - cos(x)")
+	p, _, _, _, err = NewtonAD(func(x dual.Dual) dual.Dual { return dual.Sub(x, dual.Cos(x)) }, 1, 1e-12, 1e-12, 50)
+	if err != nil || math.Abs(p-0.739085133215161) > 1e-12 {
+		t.Errorf("wrong estimation for case: x - cos(x). expecting: %f, receiving %f (%v)", 0.739085133215161, p, err)
+	}
+	t.Logf("testing case number: x - cos(x) OK")
+
+	t.Logf("testing error signals")
+	_, _, _, _, err = NewtonAD(yD, 0, 1e-12, 1e-12, 50)
+	if err != ErrZeroDerivative {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrZeroDerivative, err)
+	}
+
+	t.Logf("testing function cache")
+	evals := 0
+	yC, dyC := FromDual(func(x dual.Dual) dual.Dual {
+		evals++
+		return yD(x)
+	})
+	if yC(2) != 14 || dyC(2) != 28 || evals != 1 {
+		t.Errorf("wrong cached evaluation. expecting: 14, 28 (1 evaluation), receiving %f, %f (%d evaluations)", yC(2), dyC(2), evals)
+	}
+}
+
+func TestNewtonSystemAD(t *testing.T) {
+	// ex. 3.43, the results must match NewtonSystem with the hand written Jacobian
+	fD := func(x []dual.Dual) []dual.Dual {
+		return []dual.Dual{
+			dual.Shift(dual.Sub(dual.Sub(dual.Mul(x[0], x[0]), dual.Scale(2, x[0])), x[1]), 0.5),
+			dual.Shift(dual.Add(dual.Mul(x[0], x[0]), dual.Scale(4, dual.Mul(x[1], x[1]))), -4),
+		}
+	}
+	expected := []float64{1.900676726367066, 0.311218565419294}
+
+	t.Logf("testing case number: ex. 3.43")
+	p, _, _, _, err := NewtonSystemAD(fD, []float64{2, 0.25}, 1e-12, 1e-12, 20)
+	if err != nil {
+		t.Errorf("unexpected error for case: ex. 3.43. %v", err)
+	} else if math.Abs(p[0]-expected[0]) > 1e-12 || math.Abs(p[1]-expected[1]) > 1e-12 {
+		t.Errorf("wrong estimation for case: ex. 3.43. expecting: %v, receiving %v", expected, p)
+	}
+	t.Logf("testing case number: ex. 3.43 OK")
+
+	t.Logf("testing case number: 3x3 system")
+	p, _, _, _, err = NewtonSystemAD(func(x []dual.Dual) []dual.Dual {
+		return []dual.Dual{
+			dual.Shift(dual.Sub(dual.Scale(3, x[0]), dual.Cos(dual.Mul(x[1], x[2]))), -0.5),
+			dual.Shift(dual.Add(dual.Sub(dual.Mul(x[0], x[0]), dual.Scale(81, dual.PowConst(dual.Shift(x[1], 0.1), 2))), dual.Sin(x[2])), 1.06),
+			dual.Shift(dual.Add(dual.Exp(dual.Neg(dual.Mul(x[0], x[1]))), dual.Scale(20, x[2])), (10*math.Pi-3)/3),
+		}
+	}, []float64{0.1, 0.1, -0.1}, 1e-12, 1e-12, 20)
+	if err != nil {
+		t.Errorf("unexpected error for case: 3x3 system. %v", err)
+	} else if math.Abs(p[0]-0.5) > 1e-10 || math.Abs(p[1]) > 1e-10 || math.Abs(p[2]+math.Pi/6) > 1e-10 {
+		t.Errorf("wrong estimation for case: 3x3 system. expecting: [0.5 0 %f], receiving %v", -math.Pi/6, p)
+	}
+	t.Logf("testing case number: 3x3 system OK")
+
+	t.Logf("testing error signals")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, _, _, err = NewtonSystemADCtx(ctx, fD, []float64{2, 0.25}, 1e-12, 1e-12, 20, 0); !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	// the function and the exact Jacobian are one evaluation each
+	if _, _, _, _, err = NewtonSystemADCtx(context.Background(), fD, []float64{2, 0.25}, 1e-12, 1e-12, 20, 3); err != ErrMaxEval {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrMaxEval, err)
+	}
+}
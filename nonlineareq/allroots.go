@@ -0,0 +1,171 @@
+package nonlineareq
+
+import (
+	"math"
+	"sort"
+
+	"github.com/gonzalochief/NumericAll/utils"
+)
+
+// defaultSamples is the number of subintervals used by AllRoots when AllRootsOptions.Samples is not set
+const defaultSamples = 100
+
+// AllRootsOptions holds the settings of AllRoots
+type AllRootsOptions struct {
+	Options     // stopping criteria used to polish every zero (AbsTol, RelTol and MaxIter of DefaultOptions if 0)
+	Samples int // number of subintervals of the grid used to locate the zeros (defaultSamples if not positive)
+}
+
+// AllRoots finds every real zero of the function in the interval [a,b]
+// The interval is sampled on a grid of opts.Samples subintervals: every sign change is polished with the bisection method
+// (the faster bracketing methods stall on multiple zeros), and every local minimum of |f(x)| without a sign change is
+// refined with a golden section search and accepted as a zero of even multiplicity when f(x) changes its sign there, or
+// when |f(x)| vanishes there (below opts.FuncTol, or below the value of a double zero located with the resolution of the
+// search if FuncTol is 0). The multiplicity of every zero is estimated from f(x) ~ c(x-r)^m, and the zeros closer than the
+// tolerance are merged. As with ScanBrackets, the grid must be fine enough to separate the zeros
+// A sign change where |f(x)| does not decrease after the polishing is a pole (e.g. tan(x) at pi/2) and it is discarded
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	opts are the stopping criteria of the polishing and the number of samples
+//
+// Outputs:
+//
+//	roots are the zeros sorted from left to right, with Result.Multiplicity set
+//
+// If the polishing of a sign change fails, the sign change is skipped and the first error is returned together with the
+// zeros that were found
+func AllRoots(y YEqFuncx, a, b float64, opts AllRootsOptions) (roots []Result, err error) {
+	def := DefaultOptions()
+	if opts.AbsTol == 0 {
+		opts.AbsTol = def.AbsTol
+	}
+	if opts.RelTol == 0 {
+		opts.RelTol = def.RelTol
+	}
+	if opts.MaxIter == 0 {
+		opts.MaxIter = def.MaxIter
+	}
+	n := opts.Samples
+	if n < 1 {
+		n = defaultSamples
+	}
+	x, fx := sampleGrid(y, a, b, n)
+	step := math.Abs(b-a) / float64(n)
+	for k := 1; k < len(x); k++ {
+		// Zeros with a sign change, a zero on the grid is bracketed only once
+		if fx[k-1]*fx[k] < 0 || fx[k-1] == 0 || (k == len(x)-1 && fx[k] == 0) {
+			res, errPolish := BisectFinder{A: x[k-1], B: x[k]}.FindRoot(y, opts.Options)
+			if errPolish != nil {
+				if err == nil {
+					err = errPolish
+				}
+				continue
+			}
+			if math.Abs(res.FRoot) > math.Max(math.Abs(fx[k-1]), math.Abs(fx[k])) {
+				// |f(x)| grows towards the sign change, so it is a pole
+				continue
+			}
+			roots = append(roots, res)
+			continue
+		}
+		// Zeros without a sign change, at a local minimum of |f(x)| where the neighbours have the same sign
+		if k == len(x)-1 || fx[k-1]*fx[k] <= 0 || fx[k]*fx[k+1] <= 0 {
+			continue
+		}
+		if math.Abs(fx[k]) > math.Abs(fx[k-1]) || math.Abs(fx[k]) > math.Abs(fx[k+1]) {
+			continue
+		}
+		if res, ok := evenRoot(y, x[k-1], x[k+1], fx[k-1], fx[k+1], opts.FuncTol); ok {
+			roots = append(roots, res)
+		}
+	}
+	sort.Slice(roots, func(i, j int) bool { return roots[i].Root < roots[j].Root })
+	unique := roots[:0]
+	for _, res := range roots {
+		h := math.Min(1e-3*math.Max(math.Abs(res.Root), 1), step/4)
+		res.Multiplicity = multiplicity(y, res.Root, h)
+		if last := len(unique) - 1; last >= 0 && math.Abs(res.Root-unique[last].Root) <= mergeTol(opts.Options, res.Root) {
+			// keep the best approximation, with the highest multiplicity of both
+			prev := &unique[last]
+			m := max(res.Multiplicity, prev.Multiplicity)
+			if math.Abs(res.FRoot) < math.Abs(prev.FRoot) {
+				*prev = res
+			}
+			prev.Multiplicity = m
+			continue
+		}
+		unique = append(unique, res)
+	}
+	return unique, err
+}
+
+// minimizeTol returns the resolution of a minimum of |f(x)| around x, an even zero can not be located better than sqrt(eps)
+func minimizeTol(x float64) float64 {
+	return math.Sqrt(utils.Epsilon[float64]()) * math.Max(math.Abs(x), 1)
+}
+
+// mergeTol returns the distance below which two zeros around x are the same one
+func mergeTol(opts Options, x float64) float64 {
+	return math.Max(math.Max(opts.AbsTol, opts.RelTol*math.Abs(x)), minimizeTol(x))
+}
+
+// evenRoot minimizes |f(x)| in [a,b], where f(a) = ya and f(b) = yb have the same sign, with the golden section search
+// The minimum is a zero if f(x) changes its sign there or |f(x)| is below funcTol. If funcTol is 0, |f(x)| must not exceed
+// the value c*(x-r)^2 of a double zero r at the resolution of the search, with the curvature c estimated from the extreme
+// values, so a positive minimum (e.g. x^2 + 1e-14) is not taken as a zero
+func evenRoot(y YEqFuncx, a, b, ya, yb, funcTol float64) (res Result, ok bool) {
+	lo, hi := a, b
+	invPhi := (math.Sqrt(5) - 1) / 2
+	c := b - invPhi*(b-a)
+	d := a + invPhi*(b-a)
+	yc := math.Abs(y(c))
+	yd := math.Abs(y(d))
+	res.Evals = 2
+	for b-a > minimizeTol((a+b)/2) {
+		if yc < yd {
+			b, d, yd = d, c, yc
+			c = b - invPhi*(b-a)
+			yc = math.Abs(y(c))
+		} else {
+			a, c, yc = c, d, yd
+			d = a + invPhi*(b-a)
+			yd = math.Abs(y(d))
+		}
+		res.Iter++
+		res.Evals++
+	}
+	res.Root = (a + b) / 2
+	res.FRoot = y(res.Root)
+	res.Evals++
+	res.AbsErr = b - a
+	res.RelErr = relative(res.AbsErr, res.Root)
+	if res.FRoot == 0 || math.Signbit(res.FRoot) != math.Signbit(ya) {
+		return res, true
+	}
+	if funcTol > 0 {
+		return res, math.Abs(res.FRoot) <= funcTol
+	}
+	curvature := math.Max(math.Abs(ya)/math.Pow(res.Root-lo, 2), math.Abs(yb)/math.Pow(hi-res.Root, 2))
+	return res, math.Abs(res.FRoot) <= 4*curvature*res.AbsErr*res.AbsErr
+}
+
+// multiplicity estimates the multiplicity m of the zero r assuming f(x) ~ c(x-r)^m near r, so |f(r+2h)/f(r+h)| ~ 2^m
+// The estimates at both sides are averaged, which cancels the first order error of r
+func multiplicity(y YEqFuncx, r, h float64) int {
+	m := 0.0
+	n := 0
+	for _, side := range []float64{-h, h} {
+		y1 := math.Abs(y(r + side))
+		y2 := math.Abs(y(r + 2*side))
+		if y1 > 0 && y2 > 0 {
+			m += math.Log2(y2 / y1)
+			n++
+		}
+	}
+	if n == 0 {
+		return 1
+	}
+	return max(1, int(math.Round(m/float64(n))))
+}
@@ -0,0 +1,173 @@
+package nonlineareq
+
+import (
+	"math"
+	"testing"
+)
+
+type testStructAllRoots struct {
+	CaseNumber   string
+	Function     YEqFuncx
+	A            float64
+	B            float64
+	Samples      int
+	Expected     []float64
+	Multiplicity []int
+	Tol          float64
+}
+
+func TestAllRoots(t *testing.T) {
+	testCases := make([]testStructAllRoots, 9)
+
+	// simple zeros: sin(x) in [-1, 10] has zeros at 0, pi, 2pi and 3pi
+	testCases[0].CaseNumber = "1"
+	testCases[0].Function = math.Sin
+	testCases[0].A = -1
+	testCases[0].B = 10
+	testCases[0].Expected = []float64{0, math.Pi, 2 * math.Pi, 3 * math.Pi}
+	testCases[0].Multiplicity = []int{1, 1, 1, 1}
+	testCases[0].Tol = 1e-11
+
+	// (x-1)^2 (x+2): a double zero without sign change and a simple one
+	testCases[1].CaseNumber = "2"
+	testCases[1].Function = func(x float64) float64 {
+		return (x - 1) * (x - 1) * (x + 2)
+	}
+	testCases[1].A = -3
+	testCases[1].B = 3
+	testCases[1].Samples = 50
+	testCases[1].Expected = []float64{-2, 1}
+	testCases[1].Multiplicity = []int{1, 2}
+	testCases[1].Tol = 1e-7
+
+	// (x-0.5)^3 (x-2)^2 e^x: a triple zero with sign change and a double zero
+	testCases[2].CaseNumber = "3"
+	testCases[2].Function = func(x float64) float64 {
+		return math.Pow(x-0.5, 3) * (x - 2) * (x - 2) * math.Exp(x)
+	}
+	testCases[2].A = -1
+	testCases[2].B = 3.3
+	testCases[2].Samples = 73
+	testCases[2].Expected = []float64{0.5, 2}
+	testCases[2].Multiplicity = []int{3, 2}
+	testCases[2].Tol = 1e-5
+
+	// (x^2-2)^2 cos(x): simple zeros at +-pi/2 and two double zeros at +-sqrt(2)
+	testCases[3].CaseNumber = "4"
+	testCases[3].Function = func(x float64) float64 {
+		return (x*x - 2) * (x*x - 2) * math.Cos(x)
+	}
+	testCases[3].A = -2
+	testCases[3].B = 2
+	testCases[3].Samples = 97
+	testCases[3].Expected = []float64{-math.Pi / 2, -math.Sqrt2, math.Sqrt2, math.Pi / 2}
+	testCases[3].Multiplicity = []int{1, 2, 2, 1}
+	testCases[3].Tol = 1e-7
+
+	// a zero lying on the grid is reported once
+	testCases[4].CaseNumber = "5"
+	testCases[4].Function = func(x float64) float64 {
+		return x * (x - 1)
+	}
+	testCases[4].A = -1
+	testCases[4].B = 1
+	testCases[4].Samples = 4
+	testCases[4].Expected = []float64{0, 1}
+	testCases[4].Multiplicity = []int{1, 1}
+	testCases[4].Tol = 1e-12
+
+	// a local minimum of |f(x)| that is not a zero
+	testCases[5].CaseNumber = "6"
+	testCases[5].Function = func(x float64) float64 {
+		return x*x + 1e-3
+	}
+	testCases[5].A = -1
+	testCases[5].B = 1
+	testCases[5].Samples = 21
+	testCases[5].Tol = 1e-12
+
+	// the sign change of tan(x) at the pole pi/2 is not a zero
+	testCases[6].CaseNumber = "7"
+	testCases[6].Function = math.Tan
+	testCases[6].A = -1
+	testCases[6].B = 4
+	testCases[6].Expected = []float64{0, math.Pi}
+	testCases[6].Multiplicity = []int{1, 1}
+	testCases[6].Tol = 1e-11
+
+	// a pole without zeros
+	testCases[7].CaseNumber = "8"
+	testCases[7].Function = func(x float64) float64 {
+		return 1 / (x - 2)
+	}
+	testCases[7].A = 0
+	testCases[7].B = 3
+	testCases[7].Samples = 7
+	testCases[7].Tol = 1e-12
+
+	// a positive minimum below the resolution of the sampling is not a double zero
+	testCases[8].CaseNumber = "9"
+	testCases[8].Function = func(x float64) float64 {
+		return x*x + 1e-14
+	}
+	testCases[8].A = -1
+	testCases[8].B = 1.3
+	testCases[8].Samples = 23
+	testCases[8].Tol = 1e-12
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.CaseNumber)
+		roots, err := AllRoots(tc.Function, tc.A, tc.B, AllRootsOptions{Options: DefaultOptions(), Samples: tc.Samples})
+		if err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.CaseNumber, err)
+			continue
+		}
+		if len(roots) != len(tc.Expected) {
+			t.Errorf("wrong number of zeros for case: %s. expecting: %v, receiving %v", tc.CaseNumber, tc.Expected, roots)
+			continue
+		}
+		for k, res := range roots {
+			if math.Abs(res.Root-tc.Expected[k]) > tc.Tol || res.Multiplicity != tc.Multiplicity[k] {
+				t.Errorf("wrong estimation for case: %s. expecting: %f (multiplicity %d), receiving %f (multiplicity %d)", tc.CaseNumber, tc.Expected[k], tc.Multiplicity[k], res.Root, res.Multiplicity)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.CaseNumber)
+	}
+
+	t.Logf("testing default settings")
+	// the zero value of the settings uses DefaultOptions
+	roots, err := AllRoots(math.Sin, -1, 10, AllRootsOptions{})
+	if err != nil || len(roots) != len(testCases[0].Expected) {
+		t.Errorf("wrong estimation. expecting: %v, receiving %v (%v)", testCases[0].Expected, roots, err)
+	} else {
+		for k, res := range roots {
+			if math.Abs(res.Root-testCases[0].Expected[k]) > testCases[0].Tol {
+				t.Errorf("wrong estimation. expecting: %f, receiving %f", testCases[0].Expected[k], res.Root)
+			}
+		}
+	}
+
+	// the settings that are not set take the values of DefaultOptions
+	roots, err = AllRoots(math.Sin, -1, 10, AllRootsOptions{Options: Options{AbsTol: 1e-10}})
+	if err != nil || len(roots) != len(testCases[0].Expected) {
+		t.Errorf("wrong estimation. expecting: %v, receiving %v (%v)", testCases[0].Expected, roots, err)
+	}
+
+	t.Logf("testing error signals")
+	// the polishing errors are returned
+	opts := AllRootsOptions{Options: DefaultOptions()}
+	opts.MaxIter = 1
+	_, err = AllRoots(math.Sin, -1, 1.3, opts)
+	if err != ErrMaxIter {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrMaxIter, err)
+	}
+	// the zeros polished before and after a failure are kept
+	opts = AllRootsOptions{Options: DefaultOptions()}
+	opts.Observer = func(it Iteration) bool {
+		return it.A > 3 && it.B < 3.3
+	}
+	roots, err = AllRoots(math.Sin, -1, 10, opts)
+	if err != ErrStopped || len(roots) != 3 || math.Abs(roots[1].Root-2*math.Pi) > 1e-11 {
+		t.Errorf("wrong partial result. expecting: %v and the zeros 0, 2pi and 3pi, receiving %v (%v)", ErrStopped, err, roots)
+	}
+}
@@ -0,0 +1,88 @@
+package nonlineareq
+
+import "math"
+
+// FindBracket searches an interval [a,b] where the function changes its sign, so it can be used by the bracketing solvers
+// The function is sampled on both sides of x0 at the distances h, 2h, 4h... (the doubling of the unbounded search of
+// J. L. Bentley and A. C. Yao, An almost optimal algorithm for unbounded searching, 1976), and the interval between the
+// last two samples of the side where the sign changes is returned, which is at most half as wide as the search
+// Inputs:
+//
+//	y is the function function
+//	x0 is the initial guess of the zero
+//	h is the initial distance from x0 (if it is not positive, 1% of |x0| or 0.01 is used)
+//	maxIter is the maximum number of doublings of the distance
+//
+// Outputs:
+//
+//	a and b are the left and right extreme values of the interval
+//
+// ErrNoBracket is returned if no sign change is found after maxIter doublings
+func FindBracket(y YEqFuncx, x0, h float64, maxIter int) (a, b float64, err error) {
+	if !(h > 0) {
+		h = 0.01 * math.Max(math.Abs(x0), 1)
+	}
+	y0 := y(x0)
+	left, yLeft := x0, y0
+	right, yRight := x0, y0
+	for i := 0; i <= maxIter; i++ {
+		// a NaN (e.g. outside the domain of the function) is never taken as a sign change
+		a, ya := x0-h, y(x0-h)
+		if ya*yLeft <= 0 {
+			return a, left, nil
+		}
+		b, yb := x0+h, y(x0+h)
+		if yRight*yb <= 0 {
+			return right, b, nil
+		}
+		left, yLeft, right, yRight = a, ya, b, yb
+		h *= 2
+	}
+	return 0, 0, ErrNoBracket
+}
+
+// ScanBrackets subdivides the interval [a,b] into n subintervals of the same width and returns every subinterval where the
+// function changes its sign. A zero that lies exactly on the grid is returned only once, as the left extreme value of the
+// subinterval. Zeros of even multiplicity (with no sign change) and pairs of zeros inside the same subinterval are not
+// detected, so n must be large enough to separate the zeros
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	n is the number of subintervals
+//
+// Outputs:
+//
+//	brackets are the subintervals [a,b] with a sign change, sorted from left to right
+//
+// ErrNoBracket is returned if there is no sign change in [a,b]
+func ScanBrackets(y YEqFuncx, a, b float64, n int) (brackets [][2]float64, err error) {
+	x, fx := sampleGrid(y, a, b, n)
+	for k := 1; k < len(x); k++ {
+		if fx[k-1]*fx[k] < 0 || fx[k-1] == 0 || (k == len(x)-1 && fx[k] == 0) {
+			brackets = append(brackets, [2]float64{x[k-1], x[k]})
+		}
+	}
+	if len(brackets) == 0 {
+		return nil, ErrNoBracket
+	}
+	return brackets, nil
+}
+
+// sampleGrid evaluates the function on the n+1 points of a grid that subdivides [a,b] into n subintervals (at least one)
+func sampleGrid(y YEqFuncx, a, b float64, n int) (x, fx []float64) {
+	if n < 1 {
+		n = 1
+	}
+	h := (b - a) / float64(n)
+	x = make([]float64, n+1)
+	fx = make([]float64, n+1)
+	for k := range x {
+		x[k] = a + float64(k)*h
+		if k == n {
+			x[k] = b
+		}
+		fx[k] = y(x[k])
+	}
+	return x, fx
+}
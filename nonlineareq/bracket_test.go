@@ -0,0 +1,152 @@
+package nonlineareq
+
+import (
+	"math"
+	"testing"
+)
+
+type testStructFindBracket struct {
+	TestFunction  YEqFuncx
+	TestCaseName  string
+	X0            float64
+	H             float64
+	MaxIter       int
+	ExpectedValue float64
+	ExpectedErr   error
+}
+
+func TestFindBracket(t *testing.T) {
+	testCases := make([]testStructFindBracket, 5)
+
+	testCases[0].TestFunction = func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	testCases[0].TestCaseName = "ex. 2.2"
+	testCases[0].X0 = 0
+	testCases[0].H = 0.1
+	testCases[0].MaxIter = 50
+	testCases[0].ExpectedValue = 1.365230013414097
+
+	testCases[1].TestFunction = func(x float64) float64 {
+		return math.Exp(x) - 1e6
+	}
+	testCases[1].TestCaseName = "far zero"
+	testCases[1].X0 = -3
+	testCases[1].H = 0
+	testCases[1].MaxIter = 50
+	testCases[1].ExpectedValue = 6 * math.Ln10
+
+	testCases[2].TestFunction = func(x float64) float64 {
+		return x*x + 1
+	}
+	testCases[2].TestCaseName = "no real zero"
+	testCases[2].X0 = 0
+	testCases[2].H = 0.1
+	testCases[2].MaxIter = 20
+	testCases[2].ExpectedErr = ErrNoBracket
+
+	testCases[3].TestFunction = func(x float64) float64 {
+		return math.Atan(x - 1000)
+	}
+	testCases[3].TestCaseName = "expansion limit"
+	testCases[3].X0 = 0
+	testCases[3].H = 0.1
+	testCases[3].MaxIter = 5
+	testCases[3].ExpectedErr = ErrNoBracket
+
+	testCases[4].TestFunction = math.Log
+	testCases[4].TestCaseName = "outside the domain"
+	testCases[4].X0 = 0.5
+	testCases[4].H = 0.1
+	testCases[4].MaxIter = 20
+	testCases[4].ExpectedValue = 1
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		a, b, err := FindBracket(tc.TestFunction, tc.X0, tc.H, tc.MaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			if tc.TestFunction(a)*tc.TestFunction(b) > 0 || a > tc.ExpectedValue || b < tc.ExpectedValue {
+				t.Errorf("wrong bracket for case: %s. expecting a zero at %f, receiving [%f, %f]", tc.TestCaseName, tc.ExpectedValue, a, b)
+			}
+			// Test case: the bracket is accepted by the bracketing solvers
+			c, _, _, err := Brent(tc.TestFunction, a, b, 1e-12, 100)
+			if err != nil || math.Abs(c-tc.ExpectedValue) > 1e-9 {
+				t.Errorf("wrong estimation for case: %s. expecting: %f, receiving %f (%v)", tc.TestCaseName, tc.ExpectedValue, c, err)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
+
+type testStructScanBrackets struct {
+	TestFunction   YEqFuncx
+	TestCaseName   string
+	TestA          float64
+	TestB          float64
+	N              int
+	ExpectedValues []float64
+	ExpectedErr    error
+}
+
+func TestScanBrackets(t *testing.T) {
+	testCases := make([]testStructScanBrackets, 4)
+
+	testCases[0].TestFunction = func(x float64) float64 {
+		return math.Sin(x)
+	}
+	testCases[0].TestCaseName = "sin"
+	testCases[0].TestA = 0.5
+	testCases[0].TestB = 10
+	testCases[0].N = 100
+	testCases[0].ExpectedValues = []float64{math.Pi, 2 * math.Pi, 3 * math.Pi}
+
+	testCases[1].TestFunction = func(x float64) float64 {
+		return (x + 1) * x * (x - 2)
+	}
+	testCases[1].TestCaseName = "zeros on the grid"
+	testCases[1].TestA = -1
+	testCases[1].TestB = 2
+	testCases[1].N = 6
+	testCases[1].ExpectedValues = []float64{-1, 0, 2}
+
+	testCases[2].TestFunction = func(x float64) float64 {
+		return math.Pow(x-1, 2)
+	}
+	testCases[2].TestCaseName = "double zero"
+	testCases[2].TestA = 0
+	testCases[2].TestB = 3
+	testCases[2].N = 10
+	testCases[2].ExpectedErr = ErrNoBracket
+
+	testCases[3].TestFunction = func(x float64) float64 {
+		return math.Pow(x, 3) - 3*x + 2.5
+	}
+	testCases[3].TestCaseName = "single subinterval"
+	testCases[3].TestA = -3
+	testCases[3].TestB = 3
+	testCases[3].N = 0
+	testCases[3].ExpectedValues = []float64{-2.0536215758789726}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		brackets, err := ScanBrackets(tc.TestFunction, tc.TestA, tc.TestB, tc.N)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if len(brackets) != len(tc.ExpectedValues) {
+			t.Errorf("wrong number of brackets for case: %s. expecting: %d, receiving %d", tc.TestCaseName, len(tc.ExpectedValues), len(brackets))
+			continue
+		}
+		for k, br := range brackets {
+			// Test case: every bracket is solved by the existing solvers
+			c, _, _, err := BisectBolzano(tc.TestFunction, br[0], br[1], 1e-10)
+			if err != nil || math.Abs(c-tc.ExpectedValues[k]) > 1e-9 {
+				t.Errorf("wrong estimation for case: %s, bracket %d. expecting: %f, receiving %f (%v)", tc.TestCaseName, k, tc.ExpectedValues[k], c, err)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
@@ -0,0 +1,162 @@
+package nonlineareq
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+	"github.com/gonzalochief/NumericAll/utils"
+)
+
+// ConvergenceBehavior classifies the trend of an iterate sequence
+type ConvergenceBehavior int
+
+const (
+	Undetermined ConvergenceBehavior = iota // not enough iterates (or no clear trend) to classify the sequence
+	Converging                              // the steps shrink
+	Diverging                               // the steps grow (or the iterates overflow)
+	Oscillating                             // the steps alternate their sign without shrinking
+)
+
+// Convergence describes how fast an iterate sequence approaches its limit, |e(n+1)| ~ Constant * |e(n)|^Order
+type Convergence struct {
+	Behavior ConvergenceBehavior
+	Order    float64 // estimated order of convergence p (NaN if the sequence does not converge)
+	Constant float64 // estimated asymptotic error constant (NaN if the sequence does not converge)
+}
+
+// convergenceWindow is the number of trailing errors used to classify a sequence
+const convergenceWindow = 4
+
+// EstimateConvergence estimates the order of convergence and the asymptotic error constant of an iterate sequence (e.g.
+// the pSeries of FixPt or Recorder.Estimates), and flags divergence or oscillation
+// As the limit is unknown, the steps |x(n+1) - x(n)| are used as the errors, which have the same order and constant
+// Inputs:
+//
+//	x is the iterate sequence
+//
+// Outputs:
+//
+//	conv is the estimated convergence, at least three steps above the rounding error are needed to estimate the order
+func EstimateConvergence(x []float64) (conv Convergence) {
+	steps := make([]float64, 0, len(x))
+	for n := 1; n < len(x); n++ {
+		steps = append(steps, x[n]-x[n-1])
+	}
+	return classify(steps, x)
+}
+
+// EstimateConvergenceTo is like EstimateConvergence but uses the errors x(n) - root of a sequence with a known limit
+func EstimateConvergenceTo(x []float64, root float64) (conv Convergence) {
+	errs := make([]float64, len(x))
+	for n := range x {
+		errs[n] = x[n] - root
+	}
+	return classify(errs, x)
+}
+
+// Convergence estimates the convergence of the recorded iterations
+func (r *Recorder) Convergence() Convergence {
+	return EstimateConvergence(r.Estimates())
+}
+
+// Convergence estimates the convergence of the recorded iterations from the euclidean norms of the steps
+// The norms carry no sign, so an oscillation of the iterates is not flagged (Undetermined is returned instead)
+func (r *VecRecorder) Convergence() Convergence {
+	steps := make([]float64, 0, len(r.Trace))
+	size := make([]float64, len(r.Trace))
+	for n, it := range r.Trace {
+		size[n] = matrix.VectNorm(it.X, matrix.NormTwo)
+		if n > 0 {
+			steps = append(steps, matrix.VectNorm(vectSub(it.X, r.Trace[n-1].X), matrix.NormTwo))
+		}
+	}
+	return classify(steps, size)
+}
+
+// Convergence estimates the convergence of the recorded iterations from the moduli of the steps
+// The moduli carry no sign, so an oscillation of the iterates is not flagged (Undetermined is returned instead)
+func (r *ZRecorder) Convergence() Convergence {
+	steps := make([]float64, 0, len(r.Trace))
+	size := make([]float64, len(r.Trace))
+	for n, it := range r.Trace {
+		size[n] = cmplx.Abs(it.Z)
+		if n > 0 {
+			steps = append(steps, cmplx.Abs(it.Z-r.Trace[n-1].Z))
+		}
+	}
+	return classify(steps, size)
+}
+
+// classify estimates the convergence from the signed errors e of the iterates x
+func classify(e, x []float64) (conv Convergence) {
+	conv = Convergence{Behavior: Undetermined, Order: math.NaN(), Constant: math.NaN()}
+	for _, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			conv.Behavior = Diverging
+			return conv
+		}
+	}
+	// the errors at the level of the rounding error only carry noise, the sequence has already converged
+	eps := utils.Epsilon[float64]()
+	for n := range e {
+		if math.Abs(e[n]) <= 4*eps*math.Abs(x[n]) || e[n] == 0 {
+			e = e[:n]
+			break
+		}
+	}
+	if len(e) < 3 {
+		return conv
+	}
+	window := e[max(len(e)-convergenceWindow, 0):]
+	shrinking, growing, alternating := true, true, true
+	for n := 1; n < len(window); n++ {
+		shrinking = shrinking && math.Abs(window[n]) < math.Abs(window[n-1])
+		growing = growing && math.Abs(window[n]) > math.Abs(window[n-1])
+		alternating = alternating && window[n]*window[n-1] < 0
+	}
+	switch {
+	case shrinking:
+		conv.Behavior = Converging
+		k := len(e) - 1
+		e0, e1, e2 := math.Abs(e[k-2]), math.Abs(e[k-1]), math.Abs(e[k])
+		conv.Order = math.Log(e2/e1) / math.Log(e1/e0)
+		conv.Constant = e2 / math.Pow(e1, conv.Order)
+	case alternating:
+		conv.Behavior = Oscillating
+	case growing:
+		conv.Behavior = Diverging
+	}
+	return conv
+}
+
+// String describes the convergence, e.g. "quadratic convergence observed (p = 2.00, C = 0.81)"
+func (c Convergence) String() string {
+	switch c.Behavior {
+	case Converging:
+		return fmt.Sprintf("%s convergence observed (p = %.2f, C = %.2g)", orderName(c.Order, c.Constant), c.Order, c.Constant)
+	case Diverging:
+		return "divergence observed"
+	case Oscillating:
+		return "oscillation observed"
+	}
+	return "no convergence trend observed"
+}
+
+// orderName returns the usual name of the order of convergence p, with a constant c for the linear convergence
+func orderName(p, c float64) string {
+	switch {
+	case p < 0.85, math.Abs(p-1) < 0.15 && c >= 0.99:
+		return "sublinear"
+	case math.Abs(p-1) < 0.15:
+		return "linear"
+	case p < 1.85:
+		return "superlinear"
+	case p < 2.5:
+		return "quadratic"
+	case p < 3.5:
+		return "cubic"
+	}
+	return fmt.Sprintf("order %.0f", p)
+}
@@ -0,0 +1,114 @@
+package nonlineareq
+
+import (
+	"math"
+	"math/cmplx"
+	"strings"
+	"testing"
+)
+
+type testStructConvergence struct {
+	TestCaseName string
+	Finder       RootFinder
+	Fixed        bool
+	Order        float64
+	Description  string
+	StepsOnly    bool // the errors to the zero are not monotone, only the steps shrink steadily
+}
+
+func TestEstimateConvergence(t *testing.T) {
+	y, g, expected, _ := rootFinderCases()
+	dy := func(x float64) float64 {
+		return 3*math.Pow(x, 2) + 8*x
+	}
+	d2y := func(x float64) float64 {
+		return 6*x + 8
+	}
+	testCases := []testStructConvergence{
+		{TestCaseName: "FixPt", Finder: FixPtFinder{P0: 1.5}, Fixed: true, Order: 1, Description: "linear"},
+		{TestCaseName: "Bisect", Finder: BisectFinder{A: 1, B: 2}, Order: 1, Description: "linear", StepsOnly: true},
+		{TestCaseName: "Secant", Finder: SecantFinder{P0: 1, P1: 2}, Order: (1 + math.Sqrt(5)) / 2, Description: "superlinear"},
+		{TestCaseName: "Newton", Finder: NewtonFinder{DY: dy, P0: 1.5}, Order: 2, Description: "quadratic"},
+		{TestCaseName: "Halley", Finder: HalleyFinder{DY: dy, D2Y: d2y, P0: 3}, Order: 3, Description: "cubic"},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		fn := y
+		if tc.Fixed {
+			fn = g
+		}
+		// Test case: the convergence is estimated from the trace of the solver
+		rec := &Recorder{}
+		opts := DefaultOptions()
+		opts.Observer = rec.Observe
+		if _, err := tc.Finder.FindRoot(fn, opts); err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.TestCaseName, err)
+			continue
+		}
+		conv := rec.Convergence()
+		if conv.Behavior != Converging || math.Abs(conv.Order-tc.Order) > 0.3 || !strings.HasPrefix(conv.String(), tc.Description) {
+			t.Errorf("wrong convergence for case: %s. expecting: order %f (%s), receiving %f (%s)", tc.TestCaseName, tc.Order, tc.Description, conv.Order, conv)
+		}
+		// Test case: the known limit gives the same estimation
+		if known := EstimateConvergenceTo(rec.Estimates(), expected); !tc.StepsOnly && (known.Behavior != Converging || math.Abs(known.Order-tc.Order) > 0.3) {
+			t.Errorf("wrong convergence to the zero for case: %s. expecting: order %f, receiving %f (%s)", tc.TestCaseName, tc.Order, known.Order, known)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing FixPt series")
+	_, _, _, _, pSeries, _ := FixPt(g, 1.5, 12, 100)
+	// the asymptotic constant is |g'(p)| = 0.5*sqrt(10)/(4+p)^1.5
+	conv := EstimateConvergence(pSeries)
+	if c := 0.5 * math.Sqrt(10) / math.Pow(4+expected, 1.5); math.Abs(conv.Constant-c) > 1e-3 {
+		t.Errorf("wrong asymptotic constant. expecting: %f, receiving %f", c, conv.Constant)
+	}
+
+	t.Logf("testing error signals")
+	if conv = EstimateConvergence([]float64{0, 1, 0, 1, 0, 1}); conv.Behavior != Oscillating || conv.String() != "oscillation observed" {
+		t.Errorf("wrong behavior. expecting: oscillation observed, receiving %s", conv)
+	}
+	if conv = EstimateConvergence([]float64{1, 2, 4, 8, 16}); conv.Behavior != Diverging || !math.IsNaN(conv.Order) {
+		t.Errorf("wrong behavior. expecting: divergence observed, receiving %s", conv)
+	}
+	if conv = EstimateConvergence([]float64{1, 10, math.Inf(1)}); conv.Behavior != Diverging {
+		t.Errorf("wrong behavior. expecting: divergence observed, receiving %s", conv)
+	}
+	if conv = EstimateConvergence([]float64{1, 0.5, 0.25}); conv.Behavior != Undetermined {
+		t.Errorf("wrong behavior. expecting: no convergence trend observed, receiving %s", conv)
+	}
+}
+
+func TestRecorderConvergence(t *testing.T) {
+	t.Logf("testing case number: VecRecorder")
+	// the steps of the iterates 1 + 2^-(2^n) (1, -1) square at every iteration
+	vec := &VecRecorder{}
+	for n := 0; n < 7; n++ {
+		e := math.Pow(2, -math.Pow(2, float64(n)))
+		vec.Observe(VecIteration{Iter: n + 1, X: []float64{1 + e, 1 - e}})
+	}
+	if conv := vec.Convergence(); conv.Behavior != Converging || math.Abs(conv.Order-2) > 0.1 {
+		t.Errorf("wrong convergence for case: VecRecorder. expecting: order 2, receiving %f (%s)", conv.Order, conv)
+	}
+	t.Logf("testing case number: VecRecorder OK")
+
+	t.Logf("testing case number: ZRecorder")
+	// the iterates i + (1+i) 2^-n converge linearly with constant 1/2
+	z := &ZRecorder{}
+	for n := 0; n < 8; n++ {
+		z.Observe(ZIteration{Iter: n + 1, Z: 1i + (1+1i)*complex(math.Pow(2, -float64(n)), 0)})
+	}
+	if conv := z.Convergence(); conv.Behavior != Converging || math.Abs(conv.Order-1) > 1e-9 || math.Abs(conv.Constant-0.5) > 1e-9 {
+		t.Errorf("wrong convergence for case: ZRecorder. expecting: order 1 and constant 0.5, receiving %f and %f (%s)", conv.Order, conv.Constant, conv)
+	}
+	t.Logf("testing case number: ZRecorder OK")
+
+	t.Logf("testing error signals")
+	if conv := (&ZRecorder{Trace: []ZIteration{{Z: 1}, {Z: cmplx.Inf()}}}).Convergence(); conv.Behavior != Diverging {
+		t.Errorf("wrong behavior. expecting: divergence observed, receiving %s", conv)
+	}
+	if conv := (&VecRecorder{}).Convergence(); conv.Behavior != Undetermined {
+		t.Errorf("wrong behavior. expecting: no convergence trend observed, receiving %s", conv)
+	}
+}
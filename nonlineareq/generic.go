@@ -0,0 +1,186 @@
+package nonlineareq
+
+import (
+	"context"
+	"math"
+
+	"github.com/gonzalochief/NumericAll/utils"
+	"golang.org/x/exp/constraints"
+)
+
+// YEqFunc function type is the generic version of YEqFuncx, used to create y=f(x) type of functions for any float type
+type YEqFunc[T constraints.Float] func(x T) T
+
+// tolUlps is the number of machine epsilons (relative to the approximation) below which a tolerance can not be resolved
+const tolUlps = 4
+
+// FloatFunc converts the function y into a YEqFuncx, so it can be used by every RootFinder of the package (also as the
+// derivatives of the Newton-Raphson family). The finders iterate in float64 and evaluate y at the nearest value of T
+func FloatFunc[T constraints.Float](y YEqFunc[T]) YEqFuncx {
+	return func(x float64) float64 {
+		return float64(y(T(x)))
+	}
+}
+
+// FloatOptions returns the settings with the relative tolerance raised to tolUlps machine epsilons of T, so the finders
+// stop when the precision of T is not enough to reach the tolerances (e.g. a tolerance of 1e-12 with float32)
+func FloatOptions[T constraints.Float](opts Options) Options {
+	opts.RelTol = math.Max(opts.RelTol, 2*tolUlps*float64(utils.Epsilon[T]()))
+	return opts
+}
+
+// FixPtFloat is the generic version of FixPt, the tolerance is scaled to the precision of T
+// Inputs:
+//
+//	y is the iteration function
+//	p0 is the starting point
+//	tol is the tolerance in decimal places
+//	maxIter is the maximum number of allowed iterations
+//
+// Outputs:
+//
+//	i last iteration
+//	pAprox fixed point approximation
+//	errAprox Absolute error
+//	relErr relative error
+//	pSeries fixed point iterations
+func FixPtFloat[T constraints.Float](y YEqFunc[T], p0 T, tol int, maxIter int) (i int, pAprox, errAprox, relErr T, pSeries []T, err error) {
+	return FixPtFloatCtx(context.Background(), y, p0, tol, maxIter, 0)
+}
+
+// FixPtFloatCtx is like FixPtFloat but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function (0 for no limit)
+func FixPtFloatCtx[T constraints.Float](ctx context.Context, y YEqFunc[T], p0 T, tol int, maxIter, maxEval int) (i int, pAprox, errAprox, relErr T, pSeries []T, err error) {
+	TolDec := float64(1) / math.Pow10(tol)
+	opts := FloatOptions[T](Options{AbsTol: TolDec, RelTol: TolDec, MaxIter: maxIter, MaxEval: maxEval})
+	res, series, err := fixPt(newSolver(ctx, FloatFunc(y), opts), float64(p0))
+	if err != nil {
+		return 0, 0, 0, 0, nil, err
+	}
+	pSeries = make([]T, len(series))
+	for k := range series {
+		pSeries[k] = T(series[k])
+	}
+	return res.Iter, T(res.Root), T(res.AbsErr), T(res.RelErr), pSeries, nil
+}
+
+// BisectBolzanoFloat is the generic version of BisectBolzano, the tolerance is scaled to the precision of T
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	tol is the tolerance
+//
+// Outputs:
+//
+//	c is the zero
+//	yC is the function value evaluated at c
+//	absErr is the error of the approximation
+func BisectBolzanoFloat[T constraints.Float](y YEqFunc[T], a, b, tol T) (c, yC, absErr T, err error) {
+	return BisectBolzanoFloatCtx(context.Background(), y, a, b, tol, 0)
+}
+
+// BisectBolzanoFloatCtx is like BisectBolzanoFloat but checks ctx before every evaluation (see FindRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function (0 for no limit)
+func BisectBolzanoFloatCtx[T constraints.Float](ctx context.Context, y YEqFunc[T], a, b, tol T, maxEval int) (c, yC, absErr T, err error) {
+	// the width of the bracket can not be reduced below a few machine epsilons of T
+	absTol := math.Max(float64(tol), tolUlps*float64(utils.Epsilon[T]())*(math.Abs(float64(a))+math.Abs(float64(b))))
+	opts := Options{AbsTol: absTol, MaxIter: bisectIter(float64(a), float64(b), absTol), MaxEval: maxEval}
+	res, err := FindRootCtx(ctx, BisectFinder{A: float64(a), B: float64(b)}, FloatFunc(y), opts)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return T(res.Root), T(res.FRoot), T(res.AbsErr), nil
+}
+
+// RegulaFalsiFloat is the generic version of RegulaFalsi, the tolerance for the zero is scaled to the precision of T
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	tol is the tolerance for the zero
+//	epsilon is the tolerance for f(c)
+//	maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	c is the zero
+//	yC is the function value evaluated at c
+//	absErr is the error of the approximation
+func RegulaFalsiFloat[T constraints.Float](y YEqFunc[T], a, b, tol, epsilon T, maxIter int) (c, yC, absErr T, err error) {
+	return RegulaFalsiFloatCtx(context.Background(), y, a, b, tol, epsilon, maxIter, 0)
+}
+
+// RegulaFalsiFloatCtx is like RegulaFalsiFloat but checks ctx before every evaluation (see FindRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function (0 for no limit)
+func RegulaFalsiFloatCtx[T constraints.Float](ctx context.Context, y YEqFunc[T], a, b, tol, epsilon T, maxIter, maxEval int) (c, yC, absErr T, err error) {
+	opts := FloatOptions[T](Options{AbsTol: float64(tol), FuncTol: float64(epsilon), MaxIter: maxIter, MaxEval: maxEval})
+	res, err := FindRootCtx(ctx, RegulaFalsiFinder{A: float64(a), B: float64(b)}, FloatFunc(y), opts)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return T(res.Root), T(res.FRoot), T(res.AbsErr), nil
+}
+
+// NewtonRaphsonFloat is the generic version of NewtonRaphson, the tolerance for the zero is scaled to the precision of T
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		dy is the dirivative of the function function y
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func NewtonRaphsonFloat[T constraints.Float](y, dy YEqFunc[T], p0, delta, epsilon T, maxIter int) (zeroApr, yZero, absErr T, i int, err error) {
+	return NewtonRaphsonFloatCtx(context.Background(), y, dy, p0, delta, epsilon, maxIter, 0)
+}
+
+// NewtonRaphsonFloatCtx is like NewtonRaphsonFloat but checks ctx before every evaluation (see FindRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function and its derivative (0 for no limit)
+func NewtonRaphsonFloatCtx[T constraints.Float](ctx context.Context, y, dy YEqFunc[T], p0, delta, epsilon T, maxIter, maxEval int) (zeroApr, yZero, absErr T, i int, err error) {
+	opts := FloatOptions[T](toleranceOptions(float64(delta), float64(epsilon), maxIter, maxEval))
+	res, err := FindRootCtx(ctx, NewtonFinder{DY: FloatFunc(dy), P0: float64(p0)}, FloatFunc(y), opts)
+	if err != nil {
+		return T(res.Root), T(res.FRoot), T(res.AbsErr), res.Iter, err
+	}
+	return T(res.Root), T(res.FRoot), T(res.AbsErr), iterIndex(res.Iter), nil
+}
+
+// SecantFloat is the generic version of Secant, the tolerance for the zero is scaled to the precision of T
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		p0 and P1 are the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func SecantFloat[T constraints.Float](y YEqFunc[T], p0, p1, delta, epsilon T, maxIter int) (zeroApr, yZero, absErr T, i int, err error) {
+	return SecantFloatCtx(context.Background(), y, p0, p1, delta, epsilon, maxIter, 0)
+}
+
+// SecantFloatCtx is like SecantFloat but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function (0 for no limit)
+func SecantFloatCtx[T constraints.Float](ctx context.Context, y YEqFunc[T], p0, p1, delta, epsilon T, maxIter, maxEval int) (zeroApr, yZero, absErr T, i int, err error) {
+	opts := FloatOptions[T](toleranceOptions(float64(delta), float64(epsilon), maxIter, maxEval))
+	res, err := FindRootCtx(ctx, SecantFinder{P0: float64(p0), P1: float64(p1)}, FloatFunc(y), opts)
+	if err != nil {
+		return T(res.Root), T(res.FRoot), T(res.AbsErr), res.Iter, err
+	}
+	return T(res.Root), T(res.FRoot), T(res.AbsErr), iterIndex(res.Iter), nil
+}
@@ -0,0 +1,147 @@
+package nonlineareq
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestGenericFloat64(t *testing.T) {
+	// the float64 versions must reproduce the results of the original solvers
+	y := func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	dy := func(x float64) float64 {
+		return 3*math.Pow(x, 2) + 8*x
+	}
+	g := func(x float64) float64 {
+		return math.Sqrt(10 / (4 + x))
+	}
+
+	t.Logf("testing case number: FixPt")
+	i, p, _, _, pSeries, err := FixPt(g, 1.5, 10, 50)
+	iG, pG, _, _, pSeriesG, errG := FixPtFloat[float64](g, 1.5, 10, 50)
+	if err != errG || i != iG || p != pG || len(pSeries) != len(pSeriesG) {
+		t.Errorf("wrong estimation for case: FixPt. expecting: %f (%d iterations), receiving %f (%d iterations)", p, i, pG, iG)
+	}
+
+	t.Logf("testing case number: BisectBolzano")
+	c, _, absErr, err := BisectBolzano(y, 1, 2, 1e-10)
+	cG, _, absErrG, errG := BisectBolzanoFloat[float64](y, 1, 2, 1e-10)
+	if err != errG || c != cG || absErr != absErrG {
+		t.Errorf("wrong estimation for case: BisectBolzano. expecting: %f, receiving %f", c, cG)
+	}
+
+	t.Logf("testing case number: RegulaFalsi")
+	c, _, absErr, err = RegulaFalsi(y, 1, 2, 1e-10, 1e-10, 100)
+	cG, _, absErrG, errG = RegulaFalsiFloat[float64](y, 1, 2, 1e-10, 1e-10, 100)
+	if err != errG || c != cG || absErr != absErrG {
+		t.Errorf("wrong estimation for case: RegulaFalsi. expecting: %f, receiving %f", c, cG)
+	}
+
+	t.Logf("testing case number: NewtonRaphson")
+	p, _, _, i, err = NewtonRaphson(y, dy, 1.5, 1e-12, 1e-12, 50)
+	pG, _, _, iG, errG = NewtonRaphsonFloat[float64](y, dy, 1.5, 1e-12, 1e-12, 50)
+	if err != errG || p != pG || i != iG {
+		t.Errorf("wrong estimation for case: NewtonRaphson. expecting: %f (iteration %d), receiving %f (iteration %d)", p, i, pG, iG)
+	}
+
+	t.Logf("testing case number: Secant")
+	p, _, _, i, err = Secant(y, 1, 2, 1e-12, 1e-12, 50)
+	pG, _, _, iG, errG = SecantFloat[float64](y, 1, 2, 1e-12, 1e-12, 50)
+	if err != errG || p != pG || i != iG {
+		t.Errorf("wrong estimation for case: Secant. expecting: %f (iteration %d), receiving %f (iteration %d)", p, i, pG, iG)
+	}
+}
+
+func TestGenericFloat32(t *testing.T) {
+	// the tolerances are below the precision of float32, so they must be scaled to converge
+	y := func(x float32) float32 {
+		return x*x*x + 4*x*x - 10
+	}
+	dy := func(x float32) float32 {
+		return 3*x*x + 8*x
+	}
+	g := func(x float32) float32 {
+		return float32(math.Sqrt(float64(10 / (4 + x))))
+	}
+	expected := float32(1.365230013414097)
+	// a few units in the last place of the zero
+	maxErr := 8 * (math.Nextafter32(expected, 2) - expected)
+
+	t.Logf("testing float32 solvers")
+	i, p, _, _, pSeries, err := FixPtFloat[float32](g, 1.5, 14, 100)
+	if err != nil || math.Abs(float64(p-expected)) > float64(maxErr) || len(pSeries) != i+1 {
+		t.Errorf("wrong estimation for case: FixPt. expecting: %f, receiving %f (%v)", expected, p, err)
+	}
+
+	c, yC, absErr, err := BisectBolzanoFloat[float32](y, 1, 2, 1e-12)
+	if err != nil || math.Abs(float64(c-expected)) > float64(maxErr) || absErr > maxErr {
+		t.Errorf("wrong estimation for case: BisectBolzano. expecting: %f, receiving %f, f(c) %e (%v)", expected, c, yC, err)
+	}
+
+	c, yC, _, err = RegulaFalsiFloat[float32](y, 1, 2, 1e-12, 0, 100)
+	if err != nil || math.Abs(float64(c-expected)) > float64(maxErr) {
+		t.Errorf("wrong estimation for case: RegulaFalsi. expecting: %f, receiving %f, f(c) %e (%v)", expected, c, yC, err)
+	}
+
+	p, yP, _, _, err := NewtonRaphsonFloat[float32](y, dy, 1.5, 1e-12, 0, 50)
+	if err != nil || math.Abs(float64(p-expected)) > float64(maxErr) {
+		t.Errorf("wrong estimation for case: NewtonRaphson. expecting: %f, receiving %f, f(p) %e (%v)", expected, p, yP, err)
+	}
+
+	p, yP, _, _, err = SecantFloat[float32](y, 1, 2, 1e-12, 0, 50)
+	if err != nil || math.Abs(float64(p-expected)) > float64(maxErr) {
+		t.Errorf("wrong estimation for case: Secant. expecting: %f, receiving %f, f(p) %e (%v)", expected, p, yP, err)
+	}
+
+	t.Logf("testing error signals")
+	_, _, _, err = BisectBolzanoFloat[float32](y, 2, 3, 1e-12)
+	if err != ErrFuncSignNotEqual {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrFuncSignNotEqual, err)
+	}
+	_, _, _, _, err = NewtonRaphsonFloat[float32](y, dy, 0, 1e-12, 0, 50)
+	if err != ErrZeroDerivative {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrZeroDerivative, err)
+	}
+	_, _, _, _, err = NewtonRaphsonFloatCtx[float32](context.Background(), y, dy, 1.5, 1e-12, 0, 50, 3)
+	if err != ErrMaxEval {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrMaxEval, err)
+	}
+
+	t.Logf("testing named float types")
+	// the tolerance must be scaled with the precision of the underlying type
+	type single float32
+	yS := func(x single) single {
+		return x*x*x + 4*x*x - 10
+	}
+	dyS := func(x single) single {
+		return 3*x*x + 8*x
+	}
+	pS, _, _, _, err := NewtonRaphsonFloat[single](yS, dyS, 1.5, 1e-12, 0, 50)
+	if err != nil || math.Abs(float64(float32(pS)-expected)) > float64(maxErr) {
+		t.Errorf("wrong estimation for case: named float32. expecting: %f, receiving %f (%v)", expected, pS, err)
+	}
+}
+
+func TestGenericObserver(t *testing.T) {
+	// the generic functions are solved by the finders of the package, so their iterations can be observed
+	y := func(x float32) float32 {
+		return x*x*x + 4*x*x - 10
+	}
+	dy := func(x float32) float32 {
+		return 3*x*x + 8*x
+	}
+	rec := &Recorder{}
+	opts := FloatOptions[float32](DefaultOptions())
+	opts.Observer = rec.Observe
+	res, err := NewtonFinder{DY: FloatFunc(dy), P0: 1.5}.FindRoot(FloatFunc(y), opts)
+	p, _, _, i, errG := NewtonRaphsonFloat[float32](y, dy, 1.5, 1e-12, 0, 100)
+	if err != nil || errG != nil || len(rec.Trace) != res.Iter || i != res.Iter-1 || float32(res.Root) != p {
+		t.Errorf("wrong trace. expecting: %f (%d iterations), receiving %f (%d iterations, %d recorded, %v)", p, i+1, res.Root, res.Iter, len(rec.Trace), err)
+	}
+	// without FloatOptions a tolerance below the precision of float32 can not be reached
+	if _, err := (NewtonFinder{DY: FloatFunc(dy), P0: 1.5}).FindRoot(FloatFunc(y), Options{AbsTol: 1e-300, MaxIter: 100}); err != ErrMaxIter {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrMaxIter, err)
+	}
+}
@@ -1,17 +1,83 @@
 package nonlineareq
 
 import (
+	"context"
 	"errors"
 	"math"
+	"math/cmplx"
+
+	"github.com/gonzalochief/NumericAll/utils"
 )
 
 var ErrMaxIter = errors.New("maximum number of iretations reached")
+var ErrMaxEval = errors.New("maximum number of function evaluations reached")
 var ErrFuncSignNotEqual = errors.New("the signs of y(a) and y(b) are not different")
+var ErrZeroDenom = errors.New("zero denominator in the iteration formula")
+var ErrInvalidOrder = errors.New("the order of the method must be at least 1")
+var ErrZeroDerivative = errors.New("the derivative (or secant slope) is equal to zero")
+var ErrInvalidTol = errors.New("the absolute tolerance must be greater than 0")
+var ErrStopped = errors.New("the iteration was stopped by the observer")
+var ErrNoBracket = errors.New("no sign change of the function was found")
+var ErrDiverged = errors.New("the iteration diverged (the step is not finite)")
 
 // YEqFuncx function type is used to create y=f(x) type of functions
 // the params ..float64 variable allows the user to enter configuration parameters to standard funcions (e.g. for irr estimations or any standard function which parameters change case by case).
 type YEqFuncx func(x float64) float64
 
+// ZEqFuncz function type is used to create w=f(z) type of functions over the complex plane
+// it allows the solvers to search for complex roots of functions with real coefficients (e.g. conjugate pairs of a polynomial).
+type ZEqFuncz func(z complex128) complex128
+
+// FalsiVariant selects the rule used by RegulaFalsiMod to scale the function value of the end point that is retained
+type FalsiVariant int
+
+const (
+	Illinois       FalsiVariant = iota // halves the retained function value
+	Pegasus                            // scales the retained function value by y(b)/(y(b)+y(c))
+	AndersonBjorck                     // scales the retained function value by 1-y(c)/y(b) (halves it if the factor is not positive)
+)
+
+// FixPtFinder implements RootFinder with the p(n+1) = g(p(n)) iteration, where the function y is the iteration function g
+type FixPtFinder struct {
+	P0 float64 // starting point
+}
+
+// FindRoot estimates the fixed point of the iteration function y
+func (m FixPtFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m FixPtFinder) solve(s *solver) (Result, error) {
+	res, _, err := fixPt(s, m.P0)
+	return res, err
+}
+
+// fixPt runs the fixed point iteration from p0 and also returns the iterations
+func fixPt(s *solver, p0 float64) (res Result, pSeries []float64, err error) {
+	pSeries = append(pSeries, p0)
+	for i := 1; i <= s.opts.MaxIter; i++ {
+		p, err := s.eval(pSeries[i-1])
+		if err != nil {
+			res, err = s.fail(i-1, err)
+			return res, nil, err
+		}
+		pSeries = append(pSeries, p)
+		errAprox := math.Abs(pSeries[i] - pSeries[i-1])
+		relErr := relative(errAprox, pSeries[i])
+		it := Iteration{Iter: i, X: pSeries[i], FX: pSeries[i] - pSeries[i-1], AbsErr: errAprox, RelErr: relErr}
+		if s.observe(it) {
+			res, err = s.stopped(it)
+			return res, pSeries, err
+		}
+		if s.converged(errAprox, relErr, pSeries[i]-pSeries[i-1]) {
+			res, err = s.result(pSeries[i], pSeries[i]-pSeries[i-1], errAprox, relErr, i)
+			return res, pSeries, err
+		}
+	}
+	res, err = s.fail(s.opts.MaxIter, ErrMaxIter)
+	return res, nil, err
+}
+
 // FixPt estimates the solution to the equation x = g(x) using the p(n+1) = g(p(n)) iteration, which is estimated based on an initial point
 // Inputs:
 //
@@ -28,19 +94,68 @@ type YEqFuncx func(x float64) float64
 //	relErr relative error
 //	pSeries fixed point iterations
 func FixPt(y YEqFuncx, p0 float64, tol int, maxIter int) (i int, pAprox, errAprox, relErr float64, pSeries []float64, err error) {
+	return FixPtCtx(context.Background(), y, p0, tol, maxIter, 0)
+}
+
+// FixPtCtx is like FixPt but checks ctx before every evaluation of the iteration function (see FindRootCtx), and stops
+// with ErrMaxEval once maxEval evaluations have been spent (0 for no limit). Each iteration evaluates g once
+func FixPtCtx(ctx context.Context, y YEqFuncx, p0 float64, tol int, maxIter, maxEval int) (i int, pAprox, errAprox, relErr float64, pSeries []float64, err error) {
 	TolDec := float64(1) / math.Pow10(tol)
-	epsilon := (math.Nextafter(1, 2) - 1)
-	pSeries = append(pSeries, p0)
-	for i = 1; i <= maxIter; i++ {
-		pSeries = append(pSeries, y(pSeries[i-1]))
-		errAprox = math.Abs(pSeries[i] - pSeries[i-1])
-		relErr = errAprox / (math.Abs(pSeries[i]) + epsilon)
-		pAprox = pSeries[i]
-		if (errAprox < TolDec) || (relErr < TolDec) {
-			return i, pAprox, errAprox, relErr, pSeries, nil
+	res, pSeries, err := fixPt(newSolver(ctx, y, Options{AbsTol: TolDec, RelTol: TolDec, MaxIter: maxIter, MaxEval: maxEval}), p0)
+	if err != nil {
+		return 0, 0, 0, 0, nil, err
+	}
+	return res.Iter, res.Root, res.AbsErr, res.RelErr, pSeries, nil
+}
+
+// BisectFinder implements RootFinder with the Bolzano's Bisection Method
+type BisectFinder struct {
+	A, B float64 // left and right extreme values of the interval
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m BisectFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m BisectFinder) solve(s *solver) (Result, error) {
+	a, b := m.A, m.B
+	_, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	for i := 0; i < s.opts.MaxIter; i++ {
+		c := (a + b) / 2
+		yC, err := s.eval(c)
+		if err != nil {
+			return s.fail(i, err)
+		}
+		if yC == 0 {
+			a = c
+			b = c
+		} else if (yb * yC) > 0 {
+			b = c
+			yb = yC
+		} else {
+			a = c
+		}
+		absErr := math.Abs(b - a)
+		it := Iteration{Iter: i + 1, X: c, FX: yC, A: math.Min(a, b), B: math.Max(a, b), Bracketed: true, AbsErr: absErr, RelErr: relative(absErr, c)}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if yC == 0 || math.Abs(yC) < s.opts.FuncTol {
+			return s.result(c, yC, absErr, relative(absErr, c), i+1)
+		}
+		c = (a + b) / 2
+		if s.converged(absErr, relative(absErr, c), math.NaN()) {
+			if yC, err = s.eval(c); err != nil {
+				return s.fail(i+1, err)
+			}
+			return s.result(c, yC, absErr, relative(absErr, c), i+1)
 		}
 	}
-	return 0, 0, 0, 0, nil, ErrMaxIter
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
 }
 
 // BisectBolzano estimates the value of x that makes the function equal to 0 inside the interval [a,b] using the Bolzano's Bisection Method
@@ -60,32 +175,448 @@ func FixPt(y YEqFuncx, p0 float64, tol int, maxIter int) (i int, pAprox, errApro
 //	yC is the function value evaluated at c
 //	absErr is the error of the approximation
 func BisectBolzano(y YEqFuncx, a, b, tol float64) (c, yC, absErr float64, err error) {
-	var i int
-	ya := y(a)
-	yb := y(b)
+	return BisectBolzanoCtx(context.Background(), y, a, b, tol, 0)
+}
+
+// BisectBolzanoCtx is like BisectBolzano but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function (0 for no limit), counting the two extreme values and one midpoint per halving
+func BisectBolzanoCtx(ctx context.Context, y YEqFuncx, a, b, tol float64, maxEval int) (c, yC, absErr float64, err error) {
+	res, err := FindRootCtx(ctx, BisectFinder{A: a, B: b}, y, Options{AbsTol: tol, MaxIter: bisectIter(a, b, tol), MaxEval: maxEval})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, nil
+}
+
+// checkBracket verifies that y(a) and y(b) have different signs, so [a,b] brackets a zero of the function
+func checkBracket(ya, yb float64) error {
 	if ya*yb > 0 {
-		return 0, 0, 0, ErrFuncSignNotEqual
+		return ErrFuncSignNotEqual
 	}
-	maxIter := 1 + math.Round((math.Log(b-a)-math.Log(tol))/math.Log(2))
-	for i = 0; i < int(maxIter); i++ {
-		c = (a + b) / 2
-		yC = y(c)
+	return nil
+}
+
+// bracket evaluates the function at the extreme values of the interval [a,b] and verifies that they bracket a zero
+func (s *solver) bracket(a, b float64) (ya, yb float64, err error) {
+	if ya, err = s.eval(a); err != nil {
+		return ya, yb, err
+	}
+	if yb, err = s.eval(b); err != nil {
+		return ya, yb, err
+	}
+	return ya, yb, checkBracket(ya, yb)
+}
+
+// BrentFinder implements RootFinder with the Brent's Method
+type BrentFinder struct {
+	A, B float64 // left and right extreme values of the interval
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m BrentFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m BrentFinder) solve(s *solver) (Result, error) {
+	machEps := (math.Nextafter(1, 2) - 1)
+	a, b := m.A, m.B
+	ya, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	// c is the point that brackets the zero together with b, e is the step before the last one (d)
+	c, yC := a, ya
+	d := b - a
+	e := d
+	for i := 0; i < s.opts.MaxIter; i++ {
+		// b must be the best approximation found so far
+		if math.Abs(yC) < math.Abs(yb) {
+			a, b, c = b, c, b
+			ya, yb, yC = yb, yC, yb
+		}
+		tol := 2*machEps*math.Abs(b) + 0.5*(s.opts.AbsTol+s.opts.RelTol*math.Abs(b))
+		m := 0.5 * (c - b)
+		if math.Abs(m) <= tol || yb == 0 || math.Abs(yb) < s.opts.FuncTol {
+			absErr := math.Abs(c - b)
+			return s.result(b, yb, absErr, relative(absErr, b), i)
+		}
+		if math.Abs(e) < tol || math.Abs(ya) <= math.Abs(yb) {
+			// the last steps were too small or did not improve the approximation, use bisection
+			d = m
+			e = m
+		} else {
+			// interpolation step p/q, secant if there are only two distinct points and inverse quadratic otherwise
+			var p, q float64
+			sr := yb / ya
+			if a == c {
+				p = 2 * m * sr
+				q = 1 - sr
+			} else {
+				qa := ya / yC
+				rb := yb / yC
+				p = sr * (2*m*qa*(qa-rb) - (b-a)*(rb-1))
+				q = (qa - 1) * (rb - 1) * (sr - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			// the interpolation is accepted if it falls inside the bracket and the steps shrink fast enough
+			eOld := e
+			e = d
+			if 2*p < 3*m*q-math.Abs(tol*q) && p < math.Abs(0.5*eOld*q) {
+				d = p / q
+			} else {
+				d = m
+				e = m
+			}
+		}
+		a, ya = b, yb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		if yb, err = s.eval(b); err != nil {
+			return s.fail(i, err)
+		}
+		if math.Signbit(yb) == math.Signbit(yC) && yb != 0 {
+			// the zero is between a and b
+			c, yC = a, ya
+			d = b - a
+			e = d
+		}
+		absErr := math.Abs(c - b)
+		it := Iteration{Iter: i + 1, X: b, FX: yb, A: math.Min(b, c), B: math.Max(b, c), Bracketed: true, AbsErr: absErr, RelErr: relative(absErr, b)}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// Brent estimates the value of x that makes the function equal to 0 inside the interval [a,b] using the Brent's Method
+// The method combines bisection, secant and inverse quadratic interpolation steps. It keeps the bracketing guarantee
+// of the Bisection Method while converging superlinearly for well behaved functions, so it is the recommended default
+// The implementation follows the procedure zero of R. P. Brent, Algorithms for Minimization without Derivatives (1973)
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	tol is the tolerance for the zero
+//	maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	c is the zero
+//	yC is the function value evaluated at c
+//	absErr is the width of the last bracket
+func Brent(y YEqFuncx, a, b, tol float64, maxIter int) (c, yC, absErr float64, err error) {
+	return BrentCtx(context.Background(), y, a, b, tol, maxIter, 0)
+}
+
+// BrentCtx is like Brent but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after maxEval
+// evaluations of the function (0 for no limit). Every iteration evaluates the function once, whatever the step it takes
+func BrentCtx(ctx context.Context, y YEqFuncx, a, b, tol float64, maxIter, maxEval int) (c, yC, absErr float64, err error) {
+	res, err := FindRootCtx(ctx, BrentFinder{A: a, B: b}, y, Options{AbsTol: tol, MaxIter: maxIter, MaxEval: maxEval})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, nil
+}
+
+// bisectIter estimates the number of halvings required to reduce the interval [a,b] below tol
+func bisectIter(a, b, tol float64) int {
+	return int(1 + math.Round((math.Log(b-a)-math.Log(tol))/math.Log(2)))
+}
+
+// RiddersFinder implements RootFinder with the Ridders' Method
+type RiddersFinder struct {
+	A, B float64 // left and right extreme values of the interval
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m RiddersFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m RiddersFinder) solve(s *solver) (Result, error) {
+	a, b := m.A, m.B
+	if a > b {
+		a, b = b, a
+	}
+	ya, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	if ya == 0 {
+		return s.result(a, ya, 0, 0, 0)
+	}
+	if yb == 0 {
+		return s.result(b, yb, 0, 0, 0)
+	}
+	cPrev := math.NaN()
+	for i := 0; i < s.opts.MaxIter; i++ {
+		xm := (a + b) / 2
+		ym, err := s.eval(xm)
+		if err != nil {
+			return s.fail(i, err)
+		}
+		sq := math.Sqrt(ym*ym - ya*yb)
+		c, yC := xm, ym
+		if sq != 0 {
+			c = xm + (xm-a)*math.Copysign(1, ya-yb)*ym/sq
+			if yC, err = s.eval(c); err != nil {
+				return s.fail(i, err)
+			}
+			// keep the smallest bracket among a, xm, c and b
+			if yC == 0 {
+				a, ya, b, yb = c, yC, c, yC
+			} else if math.Signbit(ym) != math.Signbit(yC) {
+				a, ya, b, yb = xm, ym, c, yC
+				if a > b {
+					a, ya, b, yb = b, yb, a, ya
+				}
+			} else if math.Signbit(ya) != math.Signbit(yC) {
+				b, yb = c, yC
+			} else {
+				a, ya = c, yC
+			}
+		}
+		// once the estimate converges only the far extreme of the bracket moves (by halvings), so a step below the
+		// tolerance is confirmed with a probe at half the tolerance from c, towards the far extreme, which closes the bracket
+		stalled := c == cPrev
+		if math.Abs(c-cPrev) <= s.opts.AbsTol && yC != 0 && b-a > s.opts.AbsTol {
+			stalled = false
+			probe := c + s.opts.AbsTol/2
+			if c == b {
+				probe = c - s.opts.AbsTol/2
+			}
+			yP, err := s.eval(probe)
+			if err != nil {
+				return s.fail(i, err)
+			}
+			if yP == 0 {
+				a, ya, b, yb = probe, yP, probe, yP
+			} else if math.Signbit(yP) != math.Signbit(ya) {
+				b, yb = probe, yP
+			} else {
+				a, ya = probe, yP
+			}
+			if math.Abs(yP) < math.Abs(yC) {
+				c, yC = probe, yP
+			}
+		}
+		it := Iteration{Iter: i + 1, X: c, FX: yC, A: a, B: b, Bracketed: true, AbsErr: b - a, RelErr: relative(b-a, c)}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		// a zero square root means that the midpoint is the zero, and an estimate equal to the previous one (that was not
+		// probed) can not improve
+		if sq == 0 || yC == 0 || stalled || s.converged(b-a, relative(b-a, c), yC) {
+			return s.result(c, yC, b-a, relative(b-a, c), i+1)
+		}
+		cPrev = c
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// Ridders estimates the value of x that makes the function equal to 0 inside the interval [a,b] using the Ridders' Method
+// Each iteration evaluates the midpoint of the bracket and applies an exponential interpolation through the three points,
+// which gives quadratic convergence while keeping the zero bracketed
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	tol is the tolerance
+//
+// Outputs:
+//
+//	c is the zero
+//	yC is the function value evaluated at c
+//	absErr is the error of the approximation
+func Ridders(y YEqFuncx, a, b, tol float64) (c, yC, absErr float64, err error) {
+	return RiddersCtx(context.Background(), y, a, b, tol, 0)
+}
+
+// RiddersCtx is like Ridders but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after
+// maxEval evaluations of the function (0 for no limit). An iteration evaluates the midpoint and the interpolated point,
+// plus the probe that confirms a converged estimate
+func RiddersCtx(ctx context.Context, y YEqFuncx, a, b, tol float64, maxEval int) (c, yC, absErr float64, err error) {
+	// the bracket is, at least, halved in every iteration
+	maxIter := bisectIter(math.Min(a, b), math.Max(a, b), tol) + 1
+	res, err := FindRootCtx(ctx, RiddersFinder{A: a, B: b}, y, Options{AbsTol: tol, MaxIter: maxIter, MaxEval: maxEval})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, nil
+}
+
+// ITPFinder implements RootFinder with the Interpolate-Truncate-Project Method, which requires AbsTol > 0
+type ITPFinder struct {
+	A, B float64 // left and right extreme values of the interval
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m ITPFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m ITPFinder) solve(s *solver) (Result, error) {
+	const kappa2, n0 = 2.0, 1
+	if !(s.opts.AbsTol > 0) {
+		return s.fail(0, ErrInvalidTol)
+	}
+	a, b := m.A, m.B
+	if a > b {
+		a, b = b, a
+	}
+	ya, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	if ya == 0 {
+		return s.result(a, ya, 0, 0, 0)
+	}
+	if yb == 0 {
+		return s.result(b, yb, 0, 0, 0)
+	}
+	kappa1 := 0.2 / (b - a)
+	epsilon := s.opts.AbsTol / 2
+	nHalf := int(math.Max(0, math.Ceil(math.Log2((b-a)/(2*epsilon)))))
+	nMax := nHalf + n0
+	var j int
+	for j = 0; (b - a) > 2*epsilon; j++ {
+		if j > nMax || j >= s.opts.MaxIter {
+			return s.fail(j, ErrMaxIter)
+		}
+		// Interpolation
+		xHalf := (a + b) / 2
+		xF := (yb*a - ya*b) / (yb - ya)
+		// Truncation
+		sigma := math.Copysign(1, xHalf-xF)
+		// the truncation is kept above a few ulps, otherwise xT collapses onto the extreme that already holds the zero
+		// and the same point is evaluated until the projection moves it
+		delta := math.Max(kappa1*math.Pow(b-a, kappa2), tolUlps*utils.Epsilon[float64]()*math.Max(math.Abs(a), math.Abs(b)))
+		xT := xHalf
+		if delta <= math.Abs(xHalf-xF) {
+			xT = xF + sigma*delta
+		}
+		// Projection
+		r := math.Max(0, epsilon*math.Pow(2, float64(nMax-j))-(b-a)/2)
+		c := xHalf - sigma*r
+		if math.Abs(xT-xHalf) <= r {
+			c = xT
+		}
+		// Update the bracket
+		yC, err := s.eval(c)
+		if err != nil {
+			return s.fail(j, err)
+		}
 		if yC == 0 {
 			a = c
 			b = c
-		} else if (yb * yC) > 0 {
+		} else if math.Signbit(yC) == math.Signbit(yb) {
 			b = c
 			yb = yC
 		} else {
 			a = c
-			// ya = yC // It does not affect the calculation. Used only for debugging
+			ya = yC
+		}
+		it := Iteration{Iter: j + 1, X: c, FX: yC, A: a, B: b, Bracketed: true, AbsErr: b - a, RelErr: relative(b-a, c)}
+		if s.observe(it) {
+			return s.stopped(it)
 		}
-		if (b - a) < tol {
-			c = (a + b) / 2
-			return c, y(c), math.Abs(b - a), nil
+		if (yC == 0) || (math.Abs(yC) < s.opts.FuncTol) || (relative(b-a, c) < s.opts.RelTol) {
+			return s.result(c, yC, b-a, relative(b-a, c), j+1)
 		}
 	}
-	return 0, 0, 0, ErrMaxIter
+	// the extreme with the smallest function value is already a better estimate than the midpoint
+	if math.Abs(ya) < math.Abs(yb) {
+		return s.result(a, ya, b-a, relative(b-a, a), j)
+	}
+	return s.result(b, yb, b-a, relative(b-a, b), j)
+}
+
+// ITP estimates the value of x that makes the function equal to 0 inside the interval [a,b] using the
+// Interpolate-Truncate-Project Method (Oliveira and Takahashi, 2020)
+// The regula falsi estimate is truncated towards the midpoint and projected into a neighbourhood of it, so the method never
+// needs more than one iteration over the Bisection Method, while it converges superlinearly for well behaved functions
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	tol is the tolerance
+//
+// Outputs:
+//
+//	c is the zero
+//	yC is the function value evaluated at c
+//	absErr is the error of the approximation
+func ITP(y YEqFuncx, a, b, tol float64) (c, yC, absErr float64, err error) {
+	return ITPCtx(context.Background(), y, a, b, tol, 0)
+}
+
+// ITPCtx is like ITP but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after maxEval
+// evaluations of the function (0 for no limit). The projected point is the only evaluation of each iteration
+func ITPCtx(ctx context.Context, y YEqFuncx, a, b, tol float64, maxEval int) (c, yC, absErr float64, err error) {
+	// the number of iterations is bounded by the method
+	res, err := FindRootCtx(ctx, ITPFinder{A: a, B: b}, y, Options{AbsTol: tol, MaxIter: math.MaxInt, MaxEval: maxEval})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, nil
+}
+
+// RegulaFalsiFinder implements RootFinder with the Régula Falsi Method
+type RegulaFalsiFinder struct {
+	A, B float64 // left and right extreme values of the interval
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m RegulaFalsiFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m RegulaFalsiFinder) solve(s *solver) (Result, error) {
+	var absErr float64
+	a, b := m.A, m.B
+	ya, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	for i := 0; i < s.opts.MaxIter; i++ {
+		dx := yb * (b - a) / (yb - ya)
+		c := b - dx
+		ac := c - a
+		yC, err := s.eval(c)
+		if err != nil {
+			return s.fail(i, err)
+		}
+		if yC != 0 {
+			if yb*yC > 0 {
+				b = c
+				yb = yC
+			} else {
+				a = c
+				ya = yC
+			}
+			dx = math.Min(math.Abs(dx), ac)
+			absErr = math.Abs(dx)
+		}
+		it := Iteration{Iter: i + 1, X: c, FX: yC, A: math.Min(a, b), B: math.Max(a, b), Bracketed: true, AbsErr: absErr, RelErr: relative(absErr, c)}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if yC == 0 || s.converged(absErr, relative(absErr, c), yC) {
+			return s.result(c, yC, absErr, relative(absErr, c), i+1)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
 }
 
 // RegulaFalsi estimates the value of x that makes the function equal to 0 inside the interval [a,b] using the Régula Falsi Method
@@ -103,32 +634,155 @@ func BisectBolzano(y YEqFuncx, a, b, tol float64) (c, yC, absErr float64, err er
 //	yC is the function value evaluated at c
 //	absErr is the error of the approximation
 func RegulaFalsi(y YEqFuncx, a, b, tol, epsilon float64, maxIter int) (c, yC, absErr float64, err error) {
-	var dx, ac float64
-	ya := y(a)
-	yb := y(b)
-	if ya*yb > 0 {
-		return 0, 0, 0, ErrFuncSignNotEqual
+	return RegulaFalsiCtx(context.Background(), y, a, b, tol, epsilon, maxIter, 0)
+}
+
+// RegulaFalsiCtx is like RegulaFalsi but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function (0 for no limit), one per iteration besides the extreme values
+func RegulaFalsiCtx(ctx context.Context, y YEqFuncx, a, b, tol, epsilon float64, maxIter, maxEval int) (c, yC, absErr float64, err error) {
+	res, err := FindRootCtx(ctx, RegulaFalsiFinder{A: a, B: b}, y, Options{AbsTol: tol, FuncTol: epsilon, MaxIter: maxIter, MaxEval: maxEval})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, nil
+}
+
+// RegulaFalsiModFinder implements RootFinder with the Modified Régula Falsi Method
+type RegulaFalsiModFinder struct {
+	A, B    float64      // left and right extreme values of the interval
+	Variant FalsiVariant // scaling rule of the retained end point
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m RegulaFalsiModFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m RegulaFalsiModFinder) solve(s *solver) (Result, error) {
+	var mult float64
+	a, b := m.A, m.B
+	ya, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
 	}
-	for i := 0; i < maxIter; i++ {
-		dx = yb * (b - a) / (yb - ya)
-		c = b - dx
-		ac = c - a
-		yC = y(c)
+	for i := 0; i < s.opts.MaxIter; i++ {
+		dx := yb * (b - a) / (yb - ya)
+		c := b - dx
+		yC, err := s.eval(c)
+		if err != nil {
+			return s.fail(i, err)
+		}
 		if yC == 0 {
-			return c, yC, absErr, nil
-		} else if yb*yC > 0 {
-			b = c
-			yb = yC
-		} else {
 			a = c
 			ya = yC
+		} else if yb*yC < 0 {
+			// the zero lies between b and c, b becomes the opposite end point
+			a = b
+			ya = yb
+		} else {
+			// a is retained, scale its function value
+			switch m.Variant {
+			case Pegasus:
+				mult = yb / (yb + yC)
+			case AndersonBjorck:
+				mult = 1 - yC/yb
+				if mult <= 0 {
+					mult = 0.5
+				}
+			default:
+				mult = 0.5
+			}
+			ya = mult * ya
 		}
-		dx = math.Min(math.Abs(dx), ac)
-		if (math.Abs(dx) < tol) || (math.Abs(yC) < epsilon) {
-			return c, yC, absErr, nil
+		b = c
+		yb = yC
+		absErr := math.Min(math.Abs(dx), math.Abs(b-a))
+		it := Iteration{Iter: i + 1, X: c, FX: yC, A: math.Min(a, b), B: math.Max(a, b), Bracketed: true, AbsErr: absErr, RelErr: relative(absErr, c)}
+		if s.observe(it) {
+			return s.stopped(it)
 		}
+		if yC == 0 || s.converged(absErr, relative(absErr, c), yC) {
+			return s.result(c, yC, absErr, relative(absErr, c), i+1)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// RegulaFalsiMod estimates the value of x that makes the function equal to 0 inside the interval [a,b] using the Modified Régula Falsi Method
+// When an end point is retained in two consecutive iterations its function value is scaled down following the selected variant
+// (Illinois, Pegasus or Anderson-Björck), which avoids the one-sided convergence of the classic method on convex functions
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//	y is the function function
+//	a and b are the left and right extreme values of the interval
+//	tol is the tolerance for the zero
+//	epsilon is the tolerance for f(c)
+//	maxIter is the maximum iteration for the algorithm
+//	variant is the scaling rule of the retained end point
+//
+// Outputs:
+//
+//	c is the zero
+//	yC is the function value evaluated at c
+//	absErr is the error of the approximation
+func RegulaFalsiMod(y YEqFuncx, a, b, tol, epsilon float64, maxIter int, variant FalsiVariant) (c, yC, absErr float64, err error) {
+	return RegulaFalsiModCtx(context.Background(), y, a, b, tol, epsilon, maxIter, variant, 0)
+}
+
+// RegulaFalsiModCtx is like RegulaFalsiMod but checks ctx before every evaluation (see FindRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function (0 for no limit). Scaling the retained value costs no evaluation
+func RegulaFalsiModCtx(ctx context.Context, y YEqFuncx, a, b, tol, epsilon float64, maxIter int, variant FalsiVariant, maxEval int) (c, yC, absErr float64, err error) {
+	res, err := FindRootCtx(ctx, RegulaFalsiModFinder{A: a, B: b, Variant: variant}, y, Options{AbsTol: tol, FuncTol: epsilon, MaxIter: maxIter, MaxEval: maxEval})
+	if err != nil {
+		return 0, 0, 0, err
 	}
-	return 0, 0, 0, ErrMaxIter
+	return res.Root, res.FRoot, res.AbsErr, nil
+}
+
+// NewtonFinder implements RootFinder with the Newton-Raphson Method
+type NewtonFinder struct {
+	DY YEqFuncx // dirivative of the function
+	P0 float64  // initial point for the zero approximation
+}
+
+// FindRoot estimates the zero of the function y starting from P0
+func (m NewtonFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m NewtonFinder) solve(s *solver) (Result, error) {
+	p0 := m.P0
+	yP0, err := s.eval(p0)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	var dyP0, p1 float64
+	for i := 0; i < s.opts.MaxIter; i++ {
+		if dyP0, err = s.call(m.DY, p0); err != nil {
+			return s.fail(i, err)
+		}
+		if p1, err = newtonStep(p0, yP0, dyP0); err != nil {
+			if yP0 == 0 {
+				return s.result(p0, yP0, 0, 0, i)
+			}
+			return s.fail(i, err)
+		}
+		absErr := math.Abs(p1 - p0)
+		relErr := 2 * absErr / (math.Abs(p1) + s.opts.AbsTol)
+		p0 = p1
+		if yP0, err = s.eval(p0); err != nil {
+			return s.fail(i, err)
+		}
+		it := Iteration{Iter: i + 1, X: p0, FX: yP0, AbsErr: absErr, RelErr: relErr}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if s.converged(absErr, relErr, yP0) {
+			return s.result(p0, yP0, absErr, relErr, i+1)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
 }
 
 // NewtonRaphson estimates the value of x that makes the function equal to 0 using the Newton-Raphson Method
@@ -147,18 +801,519 @@ func RegulaFalsi(y YEqFuncx, a, b, tol, epsilon float64, maxIter int) (c, yC, ab
 //	yC is the function value evaluated at P0
 //	absErr is the error of the approximation
 //	i is the iteration that generated the approximation
+//
+// ErrZeroDerivative is returned if the derivative vanishes before reaching the zero, and ErrDiverged if the step overflows
 func NewtonRaphson(y, dy YEqFuncx, p0, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, i int, err error) {
-	for i = 0; i < maxIter; i++ {
-		p1 := p0 - y(p0)/dy(p0)
-		absErr = math.Abs(p1 - p0)
-		relErr := 2 * absErr / (math.Abs(p1) + delta)
+	return NewtonRaphsonCtx(context.Background(), y, dy, p0, delta, epsilon, maxIter, 0)
+}
+
+// NewtonRaphsonCtx is like NewtonRaphson but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function and its derivative (0 for no limit), two per iteration
+func NewtonRaphsonCtx(ctx context.Context, y, dy YEqFuncx, p0, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	res, err := FindRootCtx(ctx, NewtonFinder{DY: dy, P0: p0}, y, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
+
+// newtonStep returns the Newton-Raphson update p0 - y(p0)/dy(p0), checking that the derivative does not vanish and that
+// the update is finite
+func newtonStep(p0, yP0, dyP0 float64) (p1 float64, err error) {
+	if dyP0 == 0 {
+		return math.NaN(), ErrZeroDerivative
+	}
+	p1 = p0 - yP0/dyP0
+	if math.IsInf(p1, 0) || math.IsNaN(p1) {
+		return math.NaN(), ErrDiverged
+	}
+	return p1, nil
+}
+
+// NewtonDampedFinder implements RootFinder with the Damped Newton-Raphson Method
+type NewtonDampedFinder struct {
+	DY          YEqFuncx // dirivative of the function
+	P0          float64  // initial point for the zero approximation
+	MaxHalvings int      // maximum number of step halvings in each iteration
+}
+
+// FindRoot estimates the zero of the function y starting from P0
+func (m NewtonDampedFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m NewtonDampedFinder) solve(s *solver) (Result, error) {
+	p0 := m.P0
+	yP0, err := s.eval(p0)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	var dyP0, p1, yP1 float64
+	for i := 0; i < s.opts.MaxIter; i++ {
+		if dyP0, err = s.call(m.DY, p0); err != nil {
+			return s.fail(i, err)
+		}
+		if p1, err = newtonStep(p0, yP0, dyP0); err != nil {
+			if yP0 == 0 {
+				return s.result(p0, yP0, 0, 0, i)
+			}
+			return s.fail(i, err)
+		}
+		step := p1 - p0
+		if yP1, err = s.eval(p1); err != nil {
+			return s.fail(i, err)
+		}
+		// backtracking: shrink the step while |f| grows
+		for k := 0; k < m.MaxHalvings && !(math.Abs(yP1) < math.Abs(yP0)); k++ {
+			step = step / 2
+			p1 = p0 + step
+			if yP1, err = s.eval(p1); err != nil {
+				return s.fail(i, err)
+			}
+		}
+		absErr := math.Abs(step)
+		relErr := 2 * absErr / (math.Abs(p1) + s.opts.AbsTol)
+		p0 = p1
+		yP0 = yP1
+		it := Iteration{Iter: i + 1, X: p0, FX: yP0, AbsErr: absErr, RelErr: relErr}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if s.converged(absErr, relErr, yP0) {
+			return s.result(p0, yP0, absErr, relErr, i+1)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// NewtonDamped estimates the value of x that makes the function equal to 0 using the Damped Newton-Raphson Method
+// The Newton-Raphson step is halved (backtracking line search) while |f| grows, which avoids the divergence of the
+// plain method when the initial point is far from the zero
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		dy is the dirivative of the function function y
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//	 maxHalvings is the maximum number of step halvings in each iteration
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func NewtonDamped(y, dy YEqFuncx, p0, delta, epsilon float64, maxIter, maxHalvings int) (zeroApr, yZero, absErr float64, i int, err error) {
+	return NewtonDampedCtx(context.Background(), y, dy, p0, delta, epsilon, maxIter, maxHalvings, 0)
+}
+
+// NewtonDampedCtx is like NewtonDamped but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function and its derivative (0 for no limit). Every halving of the step evaluates the
+// function again
+func NewtonDampedCtx(ctx context.Context, y, dy YEqFuncx, p0, delta, epsilon float64, maxIter, maxHalvings, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	res, err := FindRootCtx(ctx, NewtonDampedFinder{DY: dy, P0: p0, MaxHalvings: maxHalvings}, y, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
+
+// NewtonMultipleFinder implements RootFinder with the Modified Newton-Raphson Method for zeros of multiplicity m > 1, where
+// the step m*f(x)/f'(x) restores the quadratic convergence that the plain method loses. If Multiplicity is not positive, m
+// is estimated in every iteration as 1/u'(x), the reciprocal of the slope of u = f/f' between the last two iterates
+type NewtonMultipleFinder struct {
+	DY           YEqFuncx // dirivative of the function
+	P0           float64  // initial point for the zero approximation
+	Multiplicity int      // known multiplicity of the zero (0 to estimate it)
+}
+
+// FindRoot estimates the zero of the function y starting from P0, Result.Multiplicity is the multiplicity used in the
+// last step
+func (m NewtonMultipleFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m NewtonMultipleFinder) solve(s *solver) (Result, error) {
+	mult := max(m.Multiplicity, 1)
+	p0 := m.P0
+	yP0, err := s.eval(p0)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	var dyP0, p1, pPrev, uPrev float64
+	for i := 0; i < s.opts.MaxIter; i++ {
+		if dyP0, err = s.call(m.DY, p0); err != nil {
+			return s.fail(i, err)
+		}
+		if p1, err = newtonStep(p0, yP0, dyP0); err != nil {
+			if yP0 == 0 {
+				return s.multipleResult(p0, yP0, 0, 0, i, mult)
+			}
+			return s.fail(i, err)
+		}
+		// u = f/f' has a simple zero with u' = 1/m, so the secant slope of u estimates the multiplicity
+		// The slope is not used when u is dominated by rounding, and the estimate changes by at most 1 per iteration
+		u := p0 - p1
+		if m.Multiplicity < 1 && i > 0 && math.Abs(u-uPrev) > tolUlps*utils.Epsilon[float64]()*math.Max(math.Abs(p0), math.Abs(pPrev)) {
+			est := math.Round((p0 - pPrev) / (u - uPrev))
+			mult = int(math.Max(math.Min(est, float64(mult+1)), math.Max(float64(mult-1), 1)))
+		}
+		pPrev, uPrev = p0, u
+		step := float64(mult) * u
+		absErr := math.Abs(step)
+		p1 = p0 - step
+		relErr := 2 * absErr / (math.Abs(p1) + s.opts.AbsTol)
+		p0 = p1
+		if yP0, err = s.eval(p0); err != nil {
+			return s.fail(i, err)
+		}
+		it := Iteration{Iter: i + 1, X: p0, FX: yP0, AbsErr: absErr, RelErr: relErr}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if s.converged(absErr, relErr, yP0) {
+			return s.multipleResult(p0, yP0, absErr, relErr, i+1, mult)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// multipleResult is like result but also reports the multiplicity of the zero
+func (s *solver) multipleResult(root, fRoot, absErr, relErr float64, iter, mult int) (Result, error) {
+	res, err := s.result(root, fRoot, absErr, relErr, iter)
+	res.Multiplicity = mult
+	return res, err
+}
+
+// NewtonMultiple estimates the value of x that makes the function equal to 0 using the Modified Newton-Raphson Method, which
+// converges quadratically also at zeros of multiplicity greater than 1 (where NewtonRaphson converges only linearly)
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		dy is the dirivative of the function function y
+//		p0 is the initial point for the zero approximation
+//		multiplicity is the multiplicity of the zero, if it is not positive it is estimated from the iterates
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	mult is the (estimated) multiplicity of the zero
+//	i is the iteration that generated the approximation
+//
+// ErrZeroDerivative is returned if the derivative vanishes before reaching the zero, and ErrDiverged if the step overflows
+func NewtonMultiple(y, dy YEqFuncx, p0 float64, multiplicity int, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, mult, i int, err error) {
+	return NewtonMultipleCtx(context.Background(), y, dy, p0, multiplicity, delta, epsilon, maxIter, 0)
+}
+
+// NewtonMultipleCtx is like NewtonMultiple but checks ctx before every evaluation (see FindRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function and its derivative (0 for no limit)
+func NewtonMultipleCtx(ctx context.Context, y, dy YEqFuncx, p0 float64, multiplicity int, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, mult, i int, err error) {
+	res, err := FindRootCtx(ctx, NewtonMultipleFinder{DY: dy, P0: p0, Multiplicity: multiplicity}, y, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, 0, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, res.Multiplicity, iterIndex(res.Iter), nil
+}
+
+// NewtonBisectFinder implements RootFinder with the hybrid Newton-Raphson and Bisection Method
+type NewtonBisectFinder struct {
+	DY   YEqFuncx // dirivative of the function
+	A, B float64  // left and right extreme values of the interval
+}
+
+// FindRoot estimates the zero of the function y inside the interval [A,B]
+func (m NewtonBisectFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m NewtonBisectFinder) solve(s *solver) (Result, error) {
+	a, b := m.A, m.B
+	ya, yb, err := s.bracket(a, b)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	if ya == 0 {
+		return s.result(a, ya, 0, 0, 0)
+	}
+	if yb == 0 {
+		return s.result(b, yb, 0, 0, 0)
+	}
+	// the Newton-Raphson step starts from the extreme with the smallest |f|, and it is accepted if it falls inside the
+	// bracket and the last Newton-Raphson step halved |f|, otherwise the midpoint is taken
+	p0, yP0 := a, ya
+	if math.Abs(yb) < math.Abs(ya) {
+		p0, yP0 = b, yb
+	}
+	halved := true
+	for i := 0; i < s.opts.MaxIter; i++ {
+		dyP0, callErr := s.call(m.DY, p0)
+		if callErr != nil {
+			return s.fail(i, callErr)
+		}
+		p1, stepErr := newtonStep(p0, yP0, dyP0)
+		var absErr float64
+		bisection := stepErr != nil || !halved || p1 < math.Min(a, b) || p1 > math.Max(a, b)
+		if bisection {
+			// bisection step, the error is bounded by half of the bracket
+			p1 = (a + b) / 2
+			absErr = math.Abs(b-a) / 2
+		} else {
+			absErr = math.Abs(p1 - p0)
+		}
+		relErr := 2 * absErr / (math.Abs(p1) + s.opts.AbsTol)
+		yPrev := yP0
+		p0 = p1
+		if yP0, err = s.eval(p0); err != nil {
+			return s.fail(i, err)
+		}
+		if math.Signbit(yP0) == math.Signbit(ya) {
+			a, ya = p0, yP0
+		} else {
+			b, yb = p0, yP0
+		}
+		halved = bisection || math.Abs(yP0) <= math.Abs(yPrev)/2
+		it := Iteration{Iter: i + 1, X: p0, FX: yP0, A: math.Min(a, b), B: math.Max(a, b), Bracketed: true, AbsErr: absErr, RelErr: relErr}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if s.converged(absErr, relErr, yP0) || (yP0 == 0) {
+			return s.result(p0, yP0, absErr, relErr, i+1)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// NewtonBisect estimates the value of x that makes the function equal to 0 inside the interval [a,b] using a hybrid
+// Newton-Raphson and Bisection Method (J. E. Dennis and R. B. Schnabel, Numerical Methods for Unconstrained Optimization
+// and Nonlinear Equations, 1983, Section 2.4)
+// A Newton-Raphson step is taken from the last point, and the method falls back to a bisection step when the
+// Newton-Raphson update leaves the bracket, the derivative vanishes or the last Newton-Raphson step did not halve |f(x)|
+// The method only works if the values of f(a) and f(b) have different signs
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		dy is the dirivative of the function function y
+//		a and b are the left and right extreme values of the interval
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func NewtonBisect(y, dy YEqFuncx, a, b, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, i int, err error) {
+	return NewtonBisectCtx(context.Background(), y, dy, a, b, delta, epsilon, maxIter, 0)
+}
+
+// NewtonBisectCtx is like NewtonBisect but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function and its derivative (0 for no limit). The derivative is evaluated also in
+// the iterations that fall back to bisection
+func NewtonBisectCtx(ctx context.Context, y, dy YEqFuncx, a, b, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	res, err := FindRootCtx(ctx, NewtonBisectFinder{DY: dy, A: a, B: b}, y, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
+
+// HalleyFinder implements RootFinder with the Halley's Method
+type HalleyFinder struct {
+	DY  YEqFuncx // first dirivative of the function
+	D2Y YEqFuncx // second dirivative of the function
+	P0  float64  // initial point for the zero approximation
+}
+
+// FindRoot estimates the zero of the function y starting from P0
+func (m HalleyFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m HalleyFinder) solve(s *solver) (Result, error) {
+	return HouseholderFinder{DYs: []YEqFuncx{m.DY, m.D2Y}, P0: m.P0}.solve(s)
+}
+
+// Halley estimates the value of x that makes the function equal to 0 using the Halley's Method
+// The method uses the second derivative of the function to reach cubic convergence on simple zeros
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		dy is the first dirivative of the function function y
+//		d2y is the second dirivative of the function function y
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func Halley(y, dy, d2y YEqFuncx, p0, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, i int, err error) {
+	return HalleyCtx(context.Background(), y, dy, d2y, p0, delta, epsilon, maxIter, 0)
+}
+
+// HalleyCtx is like Halley but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after
+// maxEval evaluations of the function and its derivatives (0 for no limit), three per iteration
+func HalleyCtx(ctx context.Context, y, dy, d2y YEqFuncx, p0, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	return HouseholderCtx(ctx, y, []YEqFuncx{dy, d2y}, p0, delta, epsilon, maxIter, maxEval)
+}
+
+// HouseholderFinder implements RootFinder with the Householder's Method of order len(DYs)
+type HouseholderFinder struct {
+	DYs []YEqFuncx // derivatives of the function, DYs[k] is the derivative of order k+1
+	P0  float64    // initial point for the zero approximation
+}
+
+// FindRoot estimates the zero of the function y starting from P0
+func (m HouseholderFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m HouseholderFinder) solve(s *solver) (Result, error) {
+	d := len(m.DYs)
+	if d < 1 {
+		return s.fail(0, ErrInvalidOrder)
+	}
+	// derivs holds f^(k)(p0), q holds the derivatives of 1/f scaled by f^(k+1)
+	derivs := make([]float64, d+1)
+	q := make([]float64, d+1)
+	p0 := m.P0
+	yP0, err := s.eval(p0)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	for i := 0; i < s.opts.MaxIter; i++ {
+		if yP0 == 0 {
+			return s.result(p0, yP0, 0, 0, i)
+		}
+		derivs[0] = yP0
+		for k := 1; k <= d; k++ {
+			if derivs[k], err = s.call(m.DYs[k-1], p0); err != nil {
+				return s.fail(i, err)
+			}
+		}
+		// (1/f)^(n) = -(1/f) * sum_k C(n,k) f^(k) (1/f)^(n-k)
+		q[0] = 1
+		for n := 1; n <= d; n++ {
+			q[n] = 0
+			binom, fPow := 1.0, 1.0
+			for k := 1; k <= n; k++ {
+				binom = binom * float64(n-k+1) / float64(k)
+				q[n] -= binom * derivs[k] * q[n-k] * fPow
+				fPow *= yP0
+			}
+		}
+		if q[d] == 0 {
+			return s.fail(i, ErrZeroDenom)
+		}
+		p1 := p0 + float64(d)*yP0*q[d-1]/q[d]
+		absErr := math.Abs(p1 - p0)
+		relErr := 2 * absErr / (math.Abs(p1) + s.opts.AbsTol)
 		p0 = p1
-		yP0 := y(p0)
-		if (absErr < delta) || (relErr < delta) || (math.Abs(yP0) < epsilon) {
-			return p0, yP0, absErr, i, nil
+		if yP0, err = s.eval(p0); err != nil {
+			return s.fail(i, err)
+		}
+		it := Iteration{Iter: i + 1, X: p0, FX: yP0, AbsErr: absErr, RelErr: relErr}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if s.converged(absErr, relErr, yP0) {
+			return s.result(p0, yP0, absErr, relErr, i+1)
 		}
 	}
-	return math.NaN(), math.NaN(), math.NaN(), i, ErrMaxIter
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// Householder estimates the value of x that makes the function equal to 0 using the Householder's Method of order d
+// p(n+1) = p(n) + d * (1/f)^(d-1)(p(n)) / (1/f)^(d)(p(n)), where d is the number of derivatives provided
+// The method has convergence of order d+1 on simple zeros (d=1 is Newton-Raphson, d=2 is Halley)
+// Inputs:
+//
+//		y is the function function y=f(x)
+//		dys are the derivatives of the function function y, dys[k] is the derivative of order k+1
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for f(c)
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P0)
+//	yC is the function value evaluated at P0
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func Householder(y YEqFuncx, dys []YEqFuncx, p0, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, i int, err error) {
+	return HouseholderCtx(context.Background(), y, dys, p0, delta, epsilon, maxIter, 0)
+}
+
+// HouseholderCtx is like Householder but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function and its derivatives (0 for no limit), each of the len(dys) derivatives
+// counting as one evaluation
+func HouseholderCtx(ctx context.Context, y YEqFuncx, dys []YEqFuncx, p0, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	res, err := FindRootCtx(ctx, HouseholderFinder{DYs: dys, P0: p0}, y, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
+
+// SecantFinder implements RootFinder with the Secant Method
+type SecantFinder struct {
+	P0, P1 float64 // initial points for the zero approximation
+}
+
+// FindRoot estimates the zero of the function y starting from P0 and P1
+func (m SecantFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m SecantFinder) solve(s *solver) (Result, error) {
+	p0, p1 := m.P0, m.P1
+	yP0, err := s.eval(p0)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	yP1, err := s.eval(p1)
+	if err != nil {
+		return s.fail(0, err)
+	}
+	for i := 0; i < s.opts.MaxIter; i++ {
+		if yP1 == yP0 {
+			if yP1 == 0 {
+				return s.result(p1, yP1, 0, 0, i)
+			}
+			return s.fail(i, ErrZeroDerivative)
+		}
+		p2 := p1 - yP1*(p1-p0)/(yP1-yP0)
+		absErr := math.Abs(p2 - p1)
+		relErr := 2 * absErr / (math.Abs(p2) + s.opts.AbsTol)
+		p0 = p1
+		yP0 = yP1
+		p1 = p2
+		if yP1, err = s.eval(p1); err != nil {
+			return s.fail(i, err)
+		}
+		it := Iteration{Iter: i + 1, X: p1, FX: yP1, AbsErr: absErr, RelErr: relErr}
+		if s.observe(it) {
+			return s.stopped(it)
+		}
+		if s.converged(absErr, relErr, yP1) {
+			return s.result(p1, yP1, absErr, relErr, i+1)
+		}
+	}
+	return s.fail(s.opts.MaxIter, ErrMaxIter)
 }
 
 // Secant estimates the value of x that makes the function equal to 0 using the Secant Method
@@ -177,21 +1332,212 @@ func NewtonRaphson(y, dy YEqFuncx, p0, delta, epsilon float64, maxIter int) (zer
 //	yC is the function value evaluated at P0
 //	absErr is the error of the approximation
 //	i is the iteration that generated the approximation
+//
+// ErrZeroDerivative is returned if y(p1) and y(p0) are equal (zero secant slope) before reaching the zero
 func Secant(y YEqFuncx, p0, p1, delta, epsilon float64, maxIter int) (zeroApr, yZero, absErr float64, i int, err error) {
-	for i = 0; i < maxIter; i++ {
-		p2 := p1 - y(p1)*(p1-p0)/(y(p1)-y(p0))
-		absErr = math.Abs(p2 - p1)
-		relErr := 2 * absErr / (math.Abs(p2) + delta)
-		p0 = p1
-		p1 = p2
-		yZero = y(p1)
-		if (absErr < delta) || (relErr < delta) || (math.Abs(yZero) < epsilon) {
-			return p1, yZero, absErr, i, nil
+	return SecantCtx(context.Background(), y, p0, p1, delta, epsilon, maxIter, 0)
+}
+
+// SecantCtx is like Secant but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after maxEval
+// evaluations of the function (0 for no limit). Only the new point is evaluated in each iteration
+func SecantCtx(ctx context.Context, y YEqFuncx, p0, p1, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero, absErr float64, i int, err error) {
+	res, err := FindRootCtx(ctx, SecantFinder{P0: p0, P1: p1}, y, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
+
+// SteffensenFinder implements RootFinder with the Steffensen's Method, where the function y is the iteration function g
+type SteffensenFinder struct {
+	P0 float64 // starting point
+}
+
+// FindRoot estimates the fixed point of the iteration function y
+func (m SteffensenFinder) FindRoot(y YEqFuncx, opts Options) (Result, error) {
+	return m.solve(newSolver(context.Background(), y, opts))
+}
+
+func (m SteffensenFinder) solve(s *solver) (Result, error) {
+	res, _, err := steffensen(s, m.P0)
+	return res, err
+}
+
+// steffensen runs the Steffensen's Method from p0 and also returns the accelerated iterations
+func steffensen(s *solver, p0 float64) (res Result, pSeries []float64, err error) {
+	pSeries = append(pSeries, p0)
+	p1, err := s.eval(p0)
+	if err != nil {
+		res, err = s.fail(0, err)
+		return res, nil, err
+	}
+	for i := 1; i <= s.opts.MaxIter; i++ {
+		p2, err := s.eval(p1)
+		if err != nil {
+			res, err = s.fail(i-1, err)
+			return res, nil, err
+		}
+		denom := p2 - 2*p1 + p0
+		if denom == 0 {
+			if p2 == p1 {
+				// p1 is already a fixed point of g
+				errAprox := math.Abs(p1 - p0)
+				pSeries = append(pSeries, p1)
+				it := Iteration{Iter: i, X: p1, FX: 0, AbsErr: errAprox, RelErr: relative(errAprox, p1)}
+				if s.observe(it) {
+					res, err = s.stopped(it)
+					return res, pSeries, err
+				}
+				res, err = s.result(p1, 0, errAprox, relative(errAprox, p1), i)
+				return res, pSeries, err
+			}
+			res, err = s.fail(i-1, ErrZeroDenom)
+			return res, nil, err
+		}
+		pAprox := p0 - (p1-p0)*(p1-p0)/denom
+		pSeries = append(pSeries, pAprox)
+		errAprox := math.Abs(pAprox - p0)
+		relErr := relative(errAprox, pAprox)
+		p0 = pAprox
+		if p1, err = s.eval(p0); err != nil {
+			res, err = s.fail(i, err)
+			return res, nil, err
+		}
+		it := Iteration{Iter: i, X: pAprox, FX: p1 - p0, AbsErr: errAprox, RelErr: relErr}
+		if s.observe(it) {
+			res, err = s.stopped(it)
+			return res, pSeries, err
+		}
+		if s.converged(errAprox, relErr, p1-p0) {
+			res, err = s.result(pAprox, p1-p0, errAprox, relErr, i)
+			return res, pSeries, err
 		}
 	}
-	return math.NaN(), math.NaN(), math.NaN(), i, ErrMaxIter
+	res, err = s.fail(s.opts.MaxIter, ErrMaxIter)
+	return res, nil, err
+}
+
+// Steffensen estimates the solution to the equation x = g(x) using Steffensen's Method, which applies the Aitken's Δ² process
+// to the fixed point iteration p(n+1) = g(p(n)). The method converges quadratically without requiring the derivative of g
+// Inputs:
+//
+//	y is the iteration function
+//	p0 is the starting point
+//	delta is the tolerance for the absolute and relative errors
+//	epsilon is the tolerance for |g(p) - p|
+//	maxIter is the maximum number of allowed iterations
+//
+// Outputs:
+//
+//	i last iteration
+//	pAprox fixed point approximation
+//	errAprox Absolute error
+//	relErr relative error
+//	pSeries accelerated fixed point iterations
+func Steffensen(y YEqFuncx, p0, delta, epsilon float64, maxIter int) (i int, pAprox, errAprox, relErr float64, pSeries []float64, err error) {
+	return SteffensenCtx(context.Background(), y, p0, delta, epsilon, maxIter, 0)
 }
 
-func Steffensen() {}
+// SteffensenCtx is like Steffensen but checks ctx before every evaluation of the iteration function (see FindRootCtx), and
+// stops with ErrMaxEval after maxEval evaluations (0 for no limit). The Aitken's extrapolation needs two per iteration
+func SteffensenCtx(ctx context.Context, y YEqFuncx, p0, delta, epsilon float64, maxIter, maxEval int) (i int, pAprox, errAprox, relErr float64, pSeries []float64, err error) {
+	res, pSeries, err := steffensen(newSolver(ctx, y, toleranceOptions(delta, epsilon, maxIter, maxEval)), p0)
+	if err != nil {
+		return 0, 0, 0, 0, nil, err
+	}
+	return res.Iter, res.Root, res.AbsErr, res.RelErr, pSeries, nil
+}
 
-func Muller() {}
+// MullerFinder implements the Muller's Method for complex functions, its iterations are reported to the ZObserver of
+// the settings
+type MullerFinder struct {
+	P0, P1, P2 complex128 // initial points for the zero approximation
+}
+
+// FindRoot estimates the zero of the function y starting from P0, P1 and P2, FuncTol is the tolerance for |f(z)|
+func (m MullerFinder) FindRoot(y ZEqFuncz, opts Options) (ZResult, error) {
+	return m.solveZ(newSolver(context.Background(), nil, opts), y)
+}
+
+func (m MullerFinder) solveZ(s *solver, y ZEqFuncz) (ZResult, error) {
+	p0, p1, p2 := m.P0, m.P1, m.P2
+	y0, err := s.zCall(y, p0)
+	if err != nil {
+		return s.zFail(0, err)
+	}
+	y1, err := s.zCall(y, p1)
+	if err != nil {
+		return s.zFail(0, err)
+	}
+	y2, err := s.zCall(y, p2)
+	if err != nil {
+		return s.zFail(0, err)
+	}
+	for i := 0; i < s.opts.MaxIter; i++ {
+		h0 := p1 - p0
+		h1 := p2 - p1
+		if h0 == 0 || h1 == 0 || h0+h1 == 0 {
+			return s.zFail(i, ErrZeroDenom)
+		}
+		d0 := (y1 - y0) / h0
+		d1 := (y2 - y1) / h1
+		a := (d1 - d0) / (h1 + h0)
+		b := a*h1 + d1
+		disc := cmplx.Sqrt(b*b - 4*a*y2)
+		// choose the sign that gives the largest denominator (closest root to p2)
+		e := b + disc
+		if cmplx.Abs(b-disc) > cmplx.Abs(e) {
+			e = b - disc
+		}
+		if e == 0 {
+			return s.zFail(i, ErrZeroDenom)
+		}
+		p3 := p2 - 2*y2/e
+		absErr := cmplx.Abs(p3 - p2)
+		relErr := 2 * absErr / (cmplx.Abs(p3) + s.opts.AbsTol)
+		p0, p1, p2 = p1, p2, p3
+		y0, y1 = y1, y2
+		if y2, err = s.zCall(y, p2); err != nil {
+			return s.zFail(i, err)
+		}
+		it := ZIteration{Iter: i + 1, Z: p2, FZ: y2, AbsErr: absErr, RelErr: relErr}
+		if s.zObserve(it) {
+			return s.zStopped(it)
+		}
+		if s.converged(absErr, relErr, cmplx.Abs(y2)) {
+			return s.zResult(p2, y2, absErr, relErr, i+1)
+		}
+	}
+	return s.zFail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// Muller estimates the value of z that makes the function equal to 0 using the Muller's Method
+// The method fits a parabola through the last three approximations, so it is able to reach complex roots
+// even when the initial points are real
+// Inputs:
+//
+//		y is the function w=f(z)
+//		p0, p1 and p2 are the initial points for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for |f(z)|
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero
+//	yZero is the function value evaluated at zeroApr (residual)
+//	absErr is the error of the approximation
+//	i is the iteration that generated the approximation
+func Muller(y ZEqFuncz, p0, p1, p2 complex128, delta, epsilon float64, maxIter int) (zeroApr, yZero complex128, absErr float64, i int, err error) {
+	return MullerCtx(context.Background(), y, p0, p1, p2, delta, epsilon, maxIter, 0)
+}
+
+// MullerCtx is like Muller but checks ctx before every evaluation (see FindRootCtx) and stops with ErrMaxEval after
+// maxEval evaluations of the function (0 for no limit)
+func MullerCtx(ctx context.Context, y ZEqFuncz, p0, p1, p2 complex128, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero complex128, absErr float64, i int, err error) {
+	res, err := MullerFinder{P0: p0, P1: p1, P2: p2}.solveZ(newSolver(ctx, nil, toleranceOptions(delta, epsilon, maxIter, maxEval)), y)
+	if err != nil {
+		return res.Root, res.FRoot, res.AbsErr, res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
@@ -1,7 +1,10 @@
 package nonlineareq
 
 import (
+	"context"
+	"errors"
 	"math"
+	"math/cmplx"
 	"testing"
 )
 
@@ -204,9 +207,13 @@ func TestRegulaFalsi(t *testing.T) {
 		t.Logf("testing case number: %s", tc.TestCaseName)
 		c, yC, absErr, err := RegulaFalsi(tc.TestFunction, tc.TestA, tc.TestB, tc.TestTol, tc.TestEpsilon, 50)
 		if err == nil {
-			if math.Abs(yC) > tc.TestEpsilon || absErr > tc.TestTol {
+			if math.Abs(yC) > tc.TestEpsilon {
 				t.Errorf("wrong estimation for case: %s. tolerances exceeded", tc.TestCaseName)
 			}
+			// Test case: absErr is the last step, which is not negative and is shorter than the initial bracket
+			if !(absErr >= 0 && absErr < math.Abs(tc.TestB-tc.TestA)) {
+				t.Errorf("wrong absolute error for case: %s. receiving %v", tc.TestCaseName, absErr)
+			}
 			if tc.ExpectedValueYC != 0 {
 				t.Errorf("wrong estimation for case: %s. expecting: %f, receiving %f", tc.TestCaseName, tc.ExpectedValueC, c)
 			}
@@ -375,3 +382,1000 @@ func TestSecant(t *testing.T) {
 		t.Logf("testing error signals case number: %s OK", tc.TestCaseName)
 	}
 }
+
+type testStructSteffensen struct {
+	TestFunctions YEqFuncx
+	TestCaseName  string
+	InitialEst    float64
+	TestDelta     float64
+	TestEpsilon   float64
+	MaxIter       int
+	ExpectedValue float64
+	ExpectedIter  int
+	ExpectedErr   error
+}
+
+func TestSteffensen(t *testing.T) {
+
+	// Set test cases
+	testCases := make([]testStructSteffensen, 5)
+
+	testCases[0].TestFunctions = func(x float64) float64 {
+		return -4 + 4*x - (1.0/2.0)*math.Pow(x, 2)
+	}
+	testCases[0].TestCaseName = "2.1.2"
+	testCases[0].InitialEst = 3.8
+	testCases[0].TestDelta = 1e-10
+	testCases[0].TestEpsilon = 1e-10
+	testCases[0].MaxIter = 50
+	testCases[0].ExpectedValue = 4
+	testCases[0].ExpectedIter = 3
+
+	testCases[1].TestFunctions = func(x float64) float64 {
+		return 1 + 2/x
+	}
+	testCases[1].TestCaseName = "2.1.3.b"
+	testCases[1].InitialEst = 4
+	testCases[1].TestDelta = 1e-10
+	testCases[1].TestEpsilon = 1e-10
+	testCases[1].MaxIter = 50
+	testCases[1].ExpectedValue = 2
+	testCases[1].ExpectedIter = 4
+
+	testCases[2].TestFunctions = func(x float64) float64 {
+		return math.Sqrt(6 + x)
+	}
+	testCases[2].TestCaseName = "2.1.3.a"
+	testCases[2].InitialEst = 7
+	testCases[2].TestDelta = 1e-10
+	testCases[2].TestEpsilon = 1e-10
+	testCases[2].MaxIter = 50
+	testCases[2].ExpectedValue = 3
+	testCases[2].ExpectedIter = 3
+
+	testCases[3].TestFunctions = func(x float64) float64 {
+		return 0.5*x + 1.5
+	}
+	testCases[3].TestCaseName = "Fixed Point custom 1"
+	testCases[3].InitialEst = 4
+	testCases[3].TestDelta = 1e-10
+	testCases[3].TestEpsilon = 1e-10
+	testCases[3].MaxIter = 50
+	testCases[3].ExpectedValue = 3
+	testCases[3].ExpectedIter = 1
+
+	testCases[4].TestFunctions = func(x float64) float64 {
+		return x + 1
+	}
+	testCases[4].TestCaseName = "zero denominator"
+	testCases[4].InitialEst = 4
+	testCases[4].TestDelta = 1e-10
+	testCases[4].TestEpsilon = 1e-10
+	testCases[4].MaxIter = 50
+	testCases[4].ExpectedErr = ErrZeroDenom
+
+	// Test case: algorythm prformance and results
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		iter, pAprox, _, _, pSeries, err := Steffensen(tc.TestFunctions, tc.InitialEst, tc.TestDelta, tc.TestEpsilon, tc.MaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case %s. expected: %v, received: %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			if math.Abs(tc.ExpectedValue-pAprox) >= 1e-6 {
+				t.Errorf("wrong values for case %s. expected aprox: %f, received: %f", tc.TestCaseName, tc.ExpectedValue, pAprox)
+			}
+			if iter != tc.ExpectedIter {
+				t.Errorf("wrong values for case %s. expected iter: %d, received: %d", tc.TestCaseName, tc.ExpectedIter, iter)
+			}
+			if len(pSeries) != iter+1 {
+				t.Errorf("wrong series length for case %s. expected: %d, received: %d", tc.TestCaseName, iter+1, len(pSeries))
+			}
+			// Steffensen's acceleration must beat the plain fixed point iteration
+			fpIter, _, _, _, _, fpErr := FixPt(tc.TestFunctions, tc.InitialEst, 10, tc.MaxIter)
+			if fpErr == nil && fpIter < iter {
+				t.Errorf("no acceleration for case %s. fixed point iter: %d, steffensen iter: %d", tc.TestCaseName, fpIter, iter)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing error signals")
+	//test case: error maximum iterations reached
+	_, _, _, _, _, err := Steffensen(testCases[1].TestFunctions, testCases[1].InitialEst, testCases[1].TestDelta, testCases[1].TestEpsilon, 1)
+	if err != ErrMaxIter {
+		t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+	}
+	t.Logf("testing error signals OK")
+}
+
+type testStructMuller struct {
+	TestY          ZEqFuncz
+	TestCaseName   string
+	P0             complex128
+	P1             complex128
+	P2             complex128
+	TestDelta      float64
+	TestEpsilon    float64
+	TestMaxIter    int
+	ExpectedValueP complex128
+	ExpectedIter   int
+	ExpectedErr    error
+}
+
+func TestMuller(t *testing.T) {
+	testCases := make([]testStructMuller, 5)
+
+	testCases[0].TestY = func(z complex128) complex128 {
+		return z*z*z - 3*z + 2
+	}
+	testCases[0].TestCaseName = "real root"
+	testCases[0].P0 = -2.6
+	testCases[0].P1 = -2.5
+	testCases[0].P2 = -2.4
+	testCases[0].TestDelta = 1e-12
+	testCases[0].TestEpsilon = 1e-12
+	testCases[0].TestMaxIter = 50
+	testCases[0].ExpectedValueP = -2
+	testCases[0].ExpectedIter = 4
+
+	testCases[1].TestY = func(z complex128) complex128 {
+		return z*z*z*z + 1
+	}
+	testCases[1].TestCaseName = "complex root from real guesses"
+	testCases[1].P0 = 0.5
+	testCases[1].P1 = 1
+	testCases[1].P2 = 1.5
+	testCases[1].TestDelta = 1e-12
+	testCases[1].TestEpsilon = 1e-12
+	testCases[1].TestMaxIter = 50
+	testCases[1].ExpectedValueP = complex(math.Sqrt2/2, math.Sqrt2/2)
+	testCases[1].ExpectedIter = 6
+
+	testCases[2].TestY = func(z complex128) complex128 {
+		return z*z*z + z*z + z + 1
+	}
+	testCases[2].TestCaseName = "conjugate pair"
+	testCases[2].P0 = 0.5
+	testCases[2].P1 = 1
+	testCases[2].P2 = 1.5
+	testCases[2].TestDelta = 1e-12
+	testCases[2].TestEpsilon = 1e-12
+	testCases[2].TestMaxIter = 50
+	testCases[2].ExpectedValueP = 1i
+	testCases[2].ExpectedIter = 6
+
+	testCases[3].TestY = func(z complex128) complex128 {
+		return z*z*z*z + 1
+	}
+	testCases[3].TestCaseName = "maximum iterations"
+	testCases[3].P0 = 0.5
+	testCases[3].P1 = 1
+	testCases[3].P2 = 1.5
+	testCases[3].TestDelta = 1e-12
+	testCases[3].TestEpsilon = 1e-12
+	testCases[3].TestMaxIter = 2
+	testCases[3].ExpectedErr = ErrMaxIter
+
+	testCases[4].TestY = func(z complex128) complex128 {
+		return z*z + 1
+	}
+	testCases[4].TestCaseName = "repeated initial points"
+	testCases[4].P0 = 1
+	testCases[4].P1 = 1
+	testCases[4].P2 = 1.5
+	testCases[4].TestDelta = 1e-12
+	testCases[4].TestEpsilon = 1e-12
+	testCases[4].TestMaxIter = 50
+	testCases[4].ExpectedErr = ErrZeroDenom
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		p, yP, _, i, err := Muller(tc.TestY, tc.P0, tc.P1, tc.P2, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			if cmplx.Abs(tc.ExpectedValueP-p) > 1e-9 {
+				t.Errorf("wrong estimation of p for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedValueP, p)
+			}
+			if cmplx.Abs(yP) > tc.TestEpsilon {
+				t.Errorf("wrong estimation of y(p) for case: %s. expecting: 0, receiving %v", tc.TestCaseName, yP)
+			}
+			if i != tc.ExpectedIter {
+				t.Errorf("wrong estimation of i for case: %s. expecting: %d, receiving %d", tc.TestCaseName, tc.ExpectedIter, i)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing observer")
+	// Test case: the recorder collects every iteration of the finder
+	rec := &ZRecorder{}
+	opts := toleranceOptions(1e-12, 1e-12, 50, 0)
+	opts.ZObserver = rec.Observe
+	finder := MullerFinder{P0: testCases[1].P0, P1: testCases[1].P1, P2: testCases[1].P2}
+	res, err := finder.FindRoot(testCases[1].TestY, opts)
+	if err != nil || res.Iter != testCases[1].ExpectedIter+1 || len(rec.Trace) != res.Iter || res.Evals != res.Iter+3 {
+		t.Errorf("wrong trace. expecting: %d iterations, receiving %d (%d recorded, %v)", testCases[1].ExpectedIter+1, res.Iter, len(rec.Trace), err)
+	} else if last := rec.Trace[len(rec.Trace)-1]; last.Z != res.Root || last.FZ != res.FRoot {
+		t.Errorf("wrong last iteration. expecting: %v, receiving %v", res.Root, last.Z)
+	}
+	// Test case: the order of the Muller method is about 1.84
+	if conv := rec.Convergence(); conv.Behavior != Converging || math.Abs(conv.Order-1.84) > 0.3 {
+		t.Errorf("wrong convergence. expecting: order 1.84, receiving %f (%s)", conv.Order, conv)
+	}
+	// Test case: the observer stops the iteration early
+	opts.ZObserver = func(it ZIteration) bool {
+		return it.Iter == 2
+	}
+	res, err = finder.FindRoot(testCases[1].TestY, opts)
+	if err != ErrStopped || res.Iter != 2 || cmplx.IsNaN(res.Root) {
+		t.Errorf("wrong error. expecting: %v at the second iteration, receiving %v at %d", ErrStopped, err, res.Iter)
+	}
+}
+
+type testStructBrent struct {
+	TestFunction   YEqFuncx
+	TestCaseName   string
+	TestA          float64
+	TestB          float64
+	TestTol        float64
+	ExpectedValueC float64
+	CompareBisect  bool
+	ExpectedErr    error
+}
+
+func TestBrent(t *testing.T) {
+	testCases := make([]testStructBrent, 5)
+
+	testCases[0].TestFunction = func(x float64) float64 {
+		return x*math.Sin(x) - 1
+	}
+	testCases[0].TestCaseName = "ex. 2.7"
+	testCases[0].TestA = 0
+	testCases[0].TestB = 2
+	testCases[0].TestTol = 1e-10
+	testCases[0].ExpectedValueC = 1.11415714087193
+	testCases[0].CompareBisect = true
+
+	testCases[1].TestFunction = func(x float64) float64 {
+		return math.Tan(x)
+	}
+	testCases[1].TestCaseName = "2.2.10.b"
+	testCases[1].TestA = 3
+	testCases[1].TestB = 4
+	testCases[1].TestTol = 1e-10
+	testCases[1].ExpectedValueC = math.Pi
+	testCases[1].CompareBisect = true
+
+	testCases[2].TestFunction = func(x float64) float64 {
+		return math.Pow(x, 3) - 3*x + 2
+	}
+	testCases[2].TestCaseName = "ex. 2.14 bracketed"
+	testCases[2].TestA = -2.6
+	testCases[2].TestB = -1.5
+	testCases[2].TestTol = 1e-10
+	testCases[2].ExpectedValueC = -2
+	testCases[2].CompareBisect = true
+
+	// the sign change is a pole, Brent falls back to bisection steps
+	testCases[3].TestFunction = func(x float64) float64 {
+		return 1 / (x - 2)
+	}
+	testCases[3].TestCaseName = "2.2.9.b"
+	testCases[3].TestA = 1
+	testCases[3].TestB = 7
+	testCases[3].TestTol = 1e-10
+	testCases[3].ExpectedValueC = 2
+
+	testCases[4].TestFunction = func(x float64) float64 {
+		return 1 / (x - 2)
+	}
+	testCases[4].TestCaseName = "2.2.9.b fail"
+	testCases[4].TestA = 3
+	testCases[4].TestB = 7
+	testCases[4].TestTol = 0.01
+	testCases[4].ExpectedErr = ErrFuncSignNotEqual
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		evalBrent := 0
+		yBrent := func(x float64) float64 {
+			evalBrent++
+			return tc.TestFunction(x)
+		}
+		c, _, absErr, err := Brent(yBrent, tc.TestA, tc.TestB, tc.TestTol, 100)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			if math.Abs(c-tc.ExpectedValueC) > tc.TestTol {
+				t.Errorf("wrong estimation for case: %s. expecting: %f, receiving %f", tc.TestCaseName, tc.ExpectedValueC, c)
+			}
+			if absErr > tc.TestTol {
+				t.Errorf("wrong error estimation for case: %s. expecting less than: %e, receiving %e", tc.TestCaseName, tc.TestTol, absErr)
+			}
+		}
+		if tc.CompareBisect {
+			evalBisect := 0
+			yBisect := func(x float64) float64 {
+				evalBisect++
+				return tc.TestFunction(x)
+			}
+			_, _, _, err = BisectBolzano(yBisect, tc.TestA, tc.TestB, tc.TestTol)
+			if err != nil {
+				t.Errorf("unexpected bisection error for case: %s. %v", tc.TestCaseName, err)
+			}
+			if evalBrent >= evalBisect {
+				t.Errorf("no improvement for case: %s. brent evaluations: %d, bisection evaluations: %d", tc.TestCaseName, evalBrent, evalBisect)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+		t.Logf("testing error signals case number: %s", tc.TestCaseName)
+		//test case: error maximum iterations reached
+		_, _, _, err = Brent(tc.TestFunction, tc.TestA, tc.TestB, tc.TestTol, 2)
+		switch err {
+		case nil:
+			t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+		}
+		t.Logf("testing error signals case number: %s OK", tc.TestCaseName)
+	}
+}
+
+type testStructRegulaFalsiMod struct {
+	TestFunction   YEqFuncx
+	TestCaseName   string
+	TestA          float64
+	TestB          float64
+	TestTol        float64
+	TestEpsilon    float64
+	TestMaxIter    int
+	ExpectedValueC float64
+	ExpectedErr    error
+}
+
+func TestRegulaFalsiMod(t *testing.T) {
+	testCases := make([]testStructRegulaFalsiMod, 4)
+
+	testCases[0].TestFunction = func(x float64) float64 {
+		return x*math.Sin(x) - 1
+	}
+	testCases[0].TestCaseName = "ex. 2.8"
+	testCases[0].TestA = 0
+	testCases[0].TestB = 2
+	testCases[0].TestTol = 1e-12
+	testCases[0].TestEpsilon = 1e-12
+	testCases[0].TestMaxIter = 10
+	testCases[0].ExpectedValueC = 1.11415714087193
+
+	// the classic method keeps the left end point fixed and needs about 200 iterations
+	testCases[1].TestFunction = func(x float64) float64 {
+		return math.Exp(x) - 2
+	}
+	testCases[1].TestCaseName = "convex exponential"
+	testCases[1].TestA = 0
+	testCases[1].TestB = 3
+	testCases[1].TestTol = 1e-12
+	testCases[1].TestEpsilon = 1e-12
+	testCases[1].TestMaxIter = 30
+	testCases[1].ExpectedValueC = math.Ln2
+
+	testCases[2].TestFunction = func(x float64) float64 {
+		return math.Pow(x, 10) - 1
+	}
+	testCases[2].TestCaseName = "convex power"
+	testCases[2].TestA = 0
+	testCases[2].TestB = 1.3
+	testCases[2].TestTol = 1e-12
+	testCases[2].TestEpsilon = 1e-12
+	testCases[2].TestMaxIter = 30
+	testCases[2].ExpectedValueC = 1
+
+	testCases[3].TestFunction = func(x float64) float64 {
+		return x*math.Sin(x) - 1
+	}
+	testCases[3].TestCaseName = "ex. 2.8 fail"
+	testCases[3].TestA = 0
+	testCases[3].TestB = 1
+	testCases[3].TestTol = 1e-12
+	testCases[3].TestEpsilon = 1e-12
+	testCases[3].TestMaxIter = 30
+	testCases[3].ExpectedErr = ErrFuncSignNotEqual
+
+	variants := []FalsiVariant{Illinois, Pegasus, AndersonBjorck}
+	for _, tc := range testCases {
+		for _, variant := range variants {
+			t.Logf("testing case number: %s, variant %d", tc.TestCaseName, variant)
+			c, yC, absErr, err := RegulaFalsiMod(tc.TestFunction, tc.TestA, tc.TestB, tc.TestTol, tc.TestEpsilon, tc.TestMaxIter, variant)
+			if err != tc.ExpectedErr {
+				t.Errorf("wrong error for case: %s, variant %d. expecting: %v, receiving %v", tc.TestCaseName, variant, tc.ExpectedErr, err)
+			}
+			if err == nil {
+				if math.Abs(yC) > tc.TestEpsilon && absErr > tc.TestTol {
+					t.Errorf("wrong estimation for case: %s, variant %d. tolerances exceeded", tc.TestCaseName, variant)
+				}
+				if math.Abs(c-tc.ExpectedValueC) > 1e-9 {
+					t.Errorf("wrong estimation for case: %s, variant %d. expecting: %f, receiving %f", tc.TestCaseName, variant, tc.ExpectedValueC, c)
+				}
+			}
+			t.Logf("testing case number: %s, variant %d OK", tc.TestCaseName, variant)
+			t.Logf("testing error signals case number: %s, variant %d", tc.TestCaseName, variant)
+			//test case: error maximum iterations reached
+			_, _, _, err = RegulaFalsiMod(tc.TestFunction, tc.TestA, tc.TestB, tc.TestTol, tc.TestEpsilon, 3, variant)
+			switch err {
+			case nil:
+				t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+			}
+			t.Logf("testing error signals case number: %s, variant %d OK", tc.TestCaseName, variant)
+		}
+	}
+}
+
+type testStructBracketSolver struct {
+	SolverName string
+	Solver     func(y YEqFuncx, a, b, tol float64) (c, yC, absErr float64, err error)
+}
+
+func TestRiddersITP(t *testing.T) {
+	testCases := make([]testStructureBisect, 5)
+
+	testCases[0].TestFunction = func(x float64) float64 {
+		return x*math.Sin(x) - 1
+	}
+	testCases[0].TestCaseName = "ex. 2.7"
+	testCases[0].TestA = 0
+	testCases[0].TestB = 2
+	testCases[0].TestTol = 1e-10
+	testCases[0].ExpectedValueC = 1.11415714087193
+
+	testCases[1].TestFunction = func(x float64) float64 {
+		return math.Tan(x)
+	}
+	testCases[1].TestCaseName = "2.2.10.b"
+	testCases[1].TestA = 3
+	testCases[1].TestB = 4
+	testCases[1].TestTol = 1e-10
+	testCases[1].ExpectedValueC = math.Pi
+
+	testCases[2].TestFunction = func(x float64) float64 {
+		return math.Exp(x) - 2
+	}
+	testCases[2].TestCaseName = "convex exponential"
+	testCases[2].TestA = 0
+	testCases[2].TestB = 3
+	testCases[2].TestTol = 1e-10
+	testCases[2].ExpectedValueC = math.Ln2
+
+	testCases[3].TestFunction = func(x float64) float64 {
+		return 1 / (x - 2)
+	}
+	testCases[3].TestCaseName = "2.2.9.b"
+	testCases[3].TestA = 1
+	testCases[3].TestB = 7
+	testCases[3].TestTol = 1e-10
+	testCases[3].ExpectedValueC = 2
+
+	testCases[4].TestFunction = func(x float64) float64 {
+		return 1 / (x - 2)
+	}
+	testCases[4].TestCaseName = "2.2.9.b fail"
+	testCases[4].TestA = 3
+	testCases[4].TestB = 7
+	testCases[4].TestTol = 0.01
+	testCases[4].ExpectedErr = ErrFuncSignNotEqual
+
+	// Ridders and ITP share the BisectBolzano signature, so they can be swapped
+	solvers := []testStructBracketSolver{
+		{SolverName: "Bisection", Solver: BisectBolzano},
+		{SolverName: "Ridders", Solver: Ridders},
+		{SolverName: "ITP", Solver: ITP},
+	}
+	for _, tc := range testCases {
+		evals := make([]int, len(solvers))
+		for k, solver := range solvers {
+			t.Logf("testing case number: %s, solver %s", tc.TestCaseName, solver.SolverName)
+			y := func(x float64) float64 {
+				evals[k]++
+				return tc.TestFunction(x)
+			}
+			c, _, absErr, err := solver.Solver(y, tc.TestA, tc.TestB, tc.TestTol)
+			if err != tc.ExpectedErr {
+				t.Errorf("wrong error for case: %s, solver %s. expecting: %v, receiving %v", tc.TestCaseName, solver.SolverName, tc.ExpectedErr, err)
+			}
+			if err == nil {
+				if math.Abs(c-tc.ExpectedValueC) > tc.TestTol {
+					t.Errorf("wrong estimation for case: %s, solver %s. expecting: %f, receiving %f", tc.TestCaseName, solver.SolverName, tc.ExpectedValueC, c)
+				}
+				if absErr > tc.TestTol {
+					t.Errorf("wrong error estimation for case: %s, solver %s. expecting less than: %e, receiving %e", tc.TestCaseName, solver.SolverName, tc.TestTol, absErr)
+				}
+			}
+			t.Logf("testing case number: %s, solver %s OK", tc.TestCaseName, solver.SolverName)
+		}
+		// ITP worst case: one iteration over bisection (n0 = 1)
+		if evals[2] > evals[0]+1 {
+			t.Errorf("worst case exceeded for case: %s. itp evaluations: %d, bisection evaluations: %d", tc.TestCaseName, evals[2], evals[0])
+		}
+	}
+
+	// Test case: Ridders and ITP converge superlinearly on a smooth function, so they need fewer evaluations than bisection
+	t.Logf("testing evaluations case number: x^3 - 2x - 5")
+	for _, tol := range []float64{1e-6, 1e-10, 1e-14} {
+		evals := make([]int, len(solvers))
+		for k, solver := range solvers {
+			y := func(x float64) float64 {
+				evals[k]++
+				return x*x*x - 2*x - 5
+			}
+			c, _, _, err := solver.Solver(y, 2, 3, tol)
+			if err != nil || math.Abs(c-2.0945514815423265) > tol {
+				t.Errorf("wrong estimation for case: x^3 - 2x - 5 (tol %e), solver %s. expecting: %f, receiving %f (%v)", tol, solver.SolverName, 2.0945514815423265, c, err)
+			}
+		}
+		if evals[1] >= evals[0] || evals[2] >= evals[0] {
+			t.Errorf("slow convergence for case: x^3 - 2x - 5 (tol %e). bisection evaluations: %d, ridders evaluations: %d, itp evaluations: %d", tol, evals[0], evals[1], evals[2])
+		}
+	}
+	t.Logf("testing evaluations case number: x^3 - 2x - 5 OK")
+}
+
+type testStructHouseholder struct {
+	TestY          YEqFuncx
+	TestDYs        []YEqFuncx
+	TestCaseName   string
+	P0             float64
+	TestDelta      float64
+	TestEpsilon    float64
+	TestMaxIter    int
+	ExpectedValueP float64
+	ExpectedIter   int
+	ExpectedErr    error
+}
+
+func TestHalleyHouseholder(t *testing.T) {
+	testCases := make([]testStructHouseholder, 7)
+	y := func(x float64) float64 {
+		return math.Pow(x, 3) - 3*x + 2
+	}
+	dy := func(x float64) float64 {
+		return 3*math.Pow(x, 2) - 3
+	}
+	d2y := func(x float64) float64 {
+		return 6 * x
+	}
+	d3y := func(x float64) float64 {
+		return 6
+	}
+
+	// order 1 must reproduce NewtonRaphson (ex. 2.14)
+	testCases[0].TestY = y
+	testCases[0].TestDYs = []YEqFuncx{dy}
+	testCases[0].TestCaseName = "ex. 2.14 order 1"
+	testCases[0].P0 = -2.4
+	testCases[0].TestDelta = 1e-12
+	testCases[0].TestEpsilon = 1e-12
+	testCases[0].TestMaxIter = 10
+	testCases[0].ExpectedValueP = -2
+	testCases[0].ExpectedIter = 4
+
+	testCases[1].TestY = y
+	testCases[1].TestDYs = []YEqFuncx{dy, d2y}
+	testCases[1].TestCaseName = "ex. 2.14 Halley"
+	testCases[1].P0 = -2.4
+	testCases[1].TestDelta = 1e-12
+	testCases[1].TestEpsilon = 1e-12
+	testCases[1].TestMaxIter = 10
+	testCases[1].ExpectedValueP = -2
+	testCases[1].ExpectedIter = 2
+
+	testCases[2].TestY = y
+	testCases[2].TestDYs = []YEqFuncx{dy, d2y, d3y}
+	testCases[2].TestCaseName = "ex. 2.14 order 3"
+	testCases[2].P0 = -2.4
+	testCases[2].TestDelta = 1e-12
+	testCases[2].TestEpsilon = 1e-12
+	testCases[2].TestMaxIter = 10
+	testCases[2].ExpectedValueP = -2
+	testCases[2].ExpectedIter = 2
+
+	// double root, order 1 must reproduce NewtonRaphson (ex. 2.15)
+	testCases[3].TestY = y
+	testCases[3].TestDYs = []YEqFuncx{dy}
+	testCases[3].TestCaseName = "ex. 2.15 order 1"
+	testCases[3].P0 = 1.2
+	testCases[3].TestDelta = 1e-12
+	testCases[3].TestEpsilon = 1e-12
+	testCases[3].TestMaxIter = 20
+	testCases[3].ExpectedValueP = 1
+	testCases[3].ExpectedIter = 18
+
+	testCases[4].TestY = y
+	testCases[4].TestDYs = []YEqFuncx{dy, d2y}
+	testCases[4].TestCaseName = "ex. 2.15 Halley"
+	testCases[4].P0 = 1.2
+	testCases[4].TestDelta = 1e-12
+	testCases[4].TestEpsilon = 1e-12
+	testCases[4].TestMaxIter = 20
+	testCases[4].ExpectedValueP = 1
+	testCases[4].ExpectedIter = 11
+
+	testCases[5].TestY = func(x float64) float64 {
+		return math.Cos(x) - x
+	}
+	testCases[5].TestDYs = []YEqFuncx{
+		func(x float64) float64 { return -math.Sin(x) - 1 },
+		func(x float64) float64 { return -math.Cos(x) },
+		func(x float64) float64 { return math.Sin(x) },
+	}
+	testCases[5].TestCaseName = "cos(x) = x order 3"
+	testCases[5].P0 = 3
+	testCases[5].TestDelta = 1e-14
+	testCases[5].TestEpsilon = 1e-16
+	testCases[5].TestMaxIter = 10
+	testCases[5].ExpectedValueP = 0.7390851332151607
+	testCases[5].ExpectedIter = 2
+
+	testCases[6].TestY = y
+	testCases[6].TestCaseName = "no derivatives"
+	testCases[6].P0 = 1.2
+	testCases[6].TestDelta = 1e-12
+	testCases[6].TestEpsilon = 1e-12
+	testCases[6].TestMaxIter = 20
+	testCases[6].ExpectedErr = ErrInvalidOrder
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		c, yC, _, i, err := Householder(tc.TestY, tc.TestDYs, tc.P0, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			if (math.Abs(tc.ExpectedValueP-c) > 1e-6) && (math.Abs(yC) > tc.TestEpsilon) {
+				t.Errorf("wrong estimation of p for case: %s. expecting: %f, receiving %.20f", tc.TestCaseName, tc.ExpectedValueP, c)
+			}
+			if i != tc.ExpectedIter {
+				t.Errorf("wrong estimation of i for case: %s. expecting: %d, receiving %d", tc.TestCaseName, tc.ExpectedIter, i)
+			}
+			if len(tc.TestDYs) == 2 {
+				cH, _, _, iH, errH := Halley(tc.TestY, tc.TestDYs[0], tc.TestDYs[1], tc.P0, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+				if cH != c || iH != i || errH != nil {
+					t.Errorf("halley and householder of order 2 differ for case: %s", tc.TestCaseName)
+				}
+			}
+			if len(tc.TestDYs) == 1 {
+				cN, _, _, iN, errN := NewtonRaphson(tc.TestY, tc.TestDYs[0], tc.P0, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+				if math.Abs(cN-c) > 1e-15*math.Abs(c) || iN != i || errN != nil {
+					t.Errorf("newton-raphson and householder of order 1 differ for case: %s. newton: %.20f (%d iterations), householder: %.20f (%d iterations)", tc.TestCaseName, cN, iN, c, i)
+				}
+			}
+			t.Logf("testing error signals case number: %s", tc.TestCaseName)
+			//test case: error maximum iterations reached
+			_, _, _, _, err = Householder(tc.TestY, tc.TestDYs, tc.P0, tc.TestDelta, tc.TestEpsilon, 1)
+			switch err {
+			case nil:
+				t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+			}
+			t.Logf("testing error signals case number: %s OK", tc.TestCaseName)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
+
+type testStructNewtonSafe struct {
+	TestY          YEqFuncx
+	TestDY         YEqFuncx
+	TestCaseName   string
+	P0             float64
+	TestA          float64
+	TestB          float64
+	TestDelta      float64
+	TestEpsilon    float64
+	TestMaxIter    int
+	ExpectedValueP float64
+	ExpectedErr    error
+}
+
+func TestNewtonSafeguards(t *testing.T) {
+	testCases := make([]testStructNewtonSafe, 4)
+
+	// plain Newton-Raphson overshoots and diverges from p0 = 3, until 1/(1+x^2) underflows to 0 at x = -3.8e292
+	testCases[0].TestY = func(x float64) float64 {
+		return math.Atan(x)
+	}
+	testCases[0].TestDY = func(x float64) float64 {
+		return 1 / (1 + x*x)
+	}
+	testCases[0].TestCaseName = "atan"
+	testCases[0].P0 = 3
+	testCases[0].TestA = -2
+	testCases[0].TestB = 5
+	testCases[0].TestDelta = 1e-12
+	testCases[0].TestEpsilon = 1e-12
+	testCases[0].TestMaxIter = 50
+	testCases[0].ExpectedValueP = 0
+	testCases[0].ExpectedErr = ErrZeroDerivative
+
+	// flat spot at p0 = -1
+	testCases[1].TestY = func(x float64) float64 {
+		return math.Pow(x, 3) - 3*x + 2
+	}
+	testCases[1].TestDY = func(x float64) float64 {
+		return 3*math.Pow(x, 2) - 3
+	}
+	testCases[1].TestCaseName = "ex. 2.14 flat spot"
+	testCases[1].P0 = -1
+	testCases[1].TestA = -3
+	testCases[1].TestB = 0
+	testCases[1].TestDelta = 1e-12
+	testCases[1].TestEpsilon = 1e-12
+	testCases[1].TestMaxIter = 50
+	testCases[1].ExpectedValueP = -2
+	testCases[1].ExpectedErr = ErrZeroDerivative
+
+	// flat spot at p0 = 0
+	testCases[2].TestY = func(x float64) float64 {
+		return math.Cos(x)
+	}
+	testCases[2].TestDY = func(x float64) float64 {
+		return -math.Sin(x)
+	}
+	testCases[2].TestCaseName = "cos flat spot"
+	testCases[2].P0 = 0
+	testCases[2].TestA = 0
+	testCases[2].TestB = 3
+	testCases[2].TestDelta = 1e-12
+	testCases[2].TestEpsilon = 1e-12
+	testCases[2].TestMaxIter = 50
+	testCases[2].ExpectedValueP = math.Pi / 2
+	testCases[2].ExpectedErr = ErrZeroDerivative
+
+	// the first step jumps to x = 1e304, where y and dy overflow and the next step is Inf/Inf
+	testCases[3].TestY = func(x float64) float64 {
+		return math.Exp(x) - 1
+	}
+	testCases[3].TestDY = func(x float64) float64 {
+		return math.Exp(x)
+	}
+	testCases[3].TestCaseName = "exp overflow"
+	testCases[3].P0 = -700
+	testCases[3].TestA = -1
+	testCases[3].TestB = 1
+	testCases[3].TestDelta = 1e-12
+	testCases[3].TestEpsilon = 1e-12
+	testCases[3].TestMaxIter = 50
+	testCases[3].ExpectedValueP = 0
+	testCases[3].ExpectedErr = ErrDiverged
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		// Test case: plain Newton-Raphson detects the zero derivative or the divergence instead of spreading NaN
+		_, _, _, _, err := NewtonRaphson(tc.TestY, tc.TestDY, tc.P0, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		// Test case: the hybrid method converges inside the bracket
+		c, yC, _, _, err := NewtonBisect(tc.TestY, tc.TestDY, tc.TestA, tc.TestB, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+		if err != nil {
+			t.Errorf("unexpected error for case: %s, hybrid method. %v", tc.TestCaseName, err)
+		} else if math.Abs(c-tc.ExpectedValueP) > 1e-6 && math.Abs(yC) > tc.TestEpsilon {
+			t.Errorf("wrong estimation for case: %s, hybrid method. expecting: %f, receiving %f", tc.TestCaseName, tc.ExpectedValueP, c)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing damped method")
+	c, yC, _, _, err := NewtonDamped(testCases[0].TestY, testCases[0].TestDY, testCases[0].P0, 1e-12, 1e-12, 50, 20)
+	if err != nil {
+		t.Errorf("unexpected error for damped method. %v", err)
+	} else if math.Abs(c) > 1e-6 && math.Abs(yC) > 1e-12 {
+		t.Errorf("wrong estimation for damped method. expecting: 0, receiving %f", c)
+	}
+	_, _, _, _, err = NewtonDamped(testCases[1].TestY, testCases[1].TestDY, testCases[1].P0, 1e-12, 1e-12, 50, 20)
+	if err != ErrZeroDerivative {
+		t.Errorf("wrong error for damped method. expecting: %v, receiving %v", ErrZeroDerivative, err)
+	}
+	_, _, _, _, err = NewtonDamped(testCases[0].TestY, testCases[0].TestDY, testCases[0].P0, 1e-12, 1e-12, 2, 20)
+	if err != ErrMaxIter {
+		t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+	}
+
+	t.Logf("testing hybrid method bracket")
+	_, _, _, _, err = NewtonBisect(testCases[0].TestY, testCases[0].TestDY, 1, 5, 1e-12, 1e-12, 50)
+	if err != ErrFuncSignNotEqual {
+		t.Errorf("wrong error for hybrid method. expecting: %v, receiving %v", ErrFuncSignNotEqual, err)
+	}
+
+	t.Logf("testing secant zero slope")
+	_, _, _, _, err = Secant(func(x float64) float64 { return x*x - 1 }, -2, 2, 1e-12, 1e-12, 50)
+	if err != ErrZeroDerivative {
+		t.Errorf("wrong error for secant method. expecting: %v, receiving %v", ErrZeroDerivative, err)
+	}
+}
+
+type testStructNewtonMultiple struct {
+	TestY          YEqFuncx
+	TestDY         YEqFuncx
+	TestCaseName   string
+	P0             float64
+	Multiplicity   int
+	ExpectedValueP float64
+}
+
+func TestNewtonMultiple(t *testing.T) {
+	testCases := make([]testStructNewtonMultiple, 6)
+
+	testCases[0].TestY = func(x float64) float64 {
+		return (x - 1) * (x - 1) * (x + 2)
+	}
+	testCases[0].TestDY = func(x float64) float64 {
+		return 3 * (x - 1) * (x + 1)
+	}
+	testCases[0].TestCaseName = "double zero"
+	testCases[0].P0 = 3
+	testCases[0].Multiplicity = 2
+	testCases[0].ExpectedValueP = 1
+
+	testCases[1].TestY = func(x float64) float64 {
+		return math.Pow(x-0.5, 3) * math.Exp(x)
+	}
+	testCases[1].TestDY = func(x float64) float64 {
+		return math.Pow(x-0.5, 2) * (x + 2.5) * math.Exp(x)
+	}
+	testCases[1].TestCaseName = "triple zero"
+	testCases[1].P0 = 2
+	testCases[1].Multiplicity = 3
+	testCases[1].ExpectedValueP = 0.5
+
+	testCases[2].TestY = func(x float64) float64 {
+		return math.Pow(math.Sin(x), 4)
+	}
+	testCases[2].TestDY = func(x float64) float64 {
+		return 4 * math.Pow(math.Sin(x), 3) * math.Cos(x)
+	}
+	testCases[2].TestCaseName = "quadruple zero"
+	testCases[2].P0 = 2.5
+	testCases[2].Multiplicity = 4
+	testCases[2].ExpectedValueP = math.Pi
+
+	// the estimate grows by one per iteration until it reaches the multiplicity
+	testCases[3].TestY = func(x float64) float64 {
+		return math.Pow(x-1, 5)
+	}
+	testCases[3].TestDY = func(x float64) float64 {
+		return 5 * math.Pow(x-1, 4)
+	}
+	testCases[3].TestCaseName = "quintuple zero"
+	testCases[3].P0 = 3
+	testCases[3].Multiplicity = 5
+	testCases[3].ExpectedValueP = 1
+
+	testCases[4].TestY = func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	testCases[4].TestDY = func(x float64) float64 {
+		return 3*math.Pow(x, 2) + 8*x
+	}
+	testCases[4].TestCaseName = "ex. 2.2 simple zero"
+	testCases[4].P0 = 1.5
+	testCases[4].Multiplicity = 1
+	testCases[4].ExpectedValueP = 1.365230013414097
+
+	// the steps are dominated by rounding, so they must not change the estimate
+	testCases[5].TestY = testCases[4].TestY
+	testCases[5].TestDY = testCases[4].TestDY
+	testCases[5].TestCaseName = "ex. 2.2 start at the zero"
+	testCases[5].P0 = 1.3652300134141
+	testCases[5].Multiplicity = 1
+	testCases[5].ExpectedValueP = 1.365230013414097
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		// the function tolerance is disabled, f(x) vanishes long before x converges at a multiple zero
+		_, _, _, iNewton, _ := NewtonRaphson(tc.TestY, tc.TestDY, tc.P0, 1e-10, 0, 200)
+		for _, known := range []int{tc.Multiplicity, 0} {
+			p, _, _, mult, i, err := NewtonMultiple(tc.TestY, tc.TestDY, tc.P0, known, 1e-10, 0, 200)
+			if err != nil {
+				t.Errorf("unexpected error for case: %s (multiplicity %d). %v", tc.TestCaseName, known, err)
+				continue
+			}
+			if math.Abs(p-tc.ExpectedValueP) > 1e-8 || mult != tc.Multiplicity {
+				t.Errorf("wrong estimation for case: %s (multiplicity %d). expecting: %f (multiplicity %d), receiving %f (multiplicity %d)", tc.TestCaseName, known, tc.ExpectedValueP, tc.Multiplicity, p, mult)
+			}
+			// Test case: the quadratic convergence is restored
+			if tc.Multiplicity > 1 && i >= iNewton/2 {
+				t.Errorf("slow convergence for case: %s (multiplicity %d). %d iterations, %d with Newton-Raphson", tc.TestCaseName, known, i, iNewton)
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing error signals")
+	_, _, _, _, _, err := NewtonMultiple(testCases[0].TestY, testCases[0].TestDY, 3, 0, 1e-10, 0, 2)
+	if err != ErrMaxIter {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrMaxIter, err)
+	}
+	_, _, _, _, _, err = NewtonMultiple(testCases[0].TestY, testCases[0].TestDY, -1, 0, 1e-10, 0, 50)
+	if err != ErrZeroDerivative {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrZeroDerivative, err)
+	}
+}
+
+func TestSolversCtx(t *testing.T) {
+	y := func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	dy := func(x float64) float64 {
+		return 3*math.Pow(x, 2) + 8*x
+	}
+
+	t.Logf("testing cancelled context")
+	ctx, cancel := context.WithCancel(context.Background())
+	evals := 0
+	// the function cancels the context on its third evaluation, so the solvers must stop right after it
+	yCancel := func(x float64) float64 {
+		evals++
+		if evals == 3 {
+			cancel()
+		}
+		return y(x)
+	}
+	_, _, _, _, err := NewtonRaphsonCtx(ctx, yCancel, dy, 1.5, 1e-12, 1e-12, 50, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the newton method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	if evals != 3 {
+		t.Errorf("wrong evaluations after cancelling for the newton method. expecting: 3, receiving %d", evals)
+	}
+	// the context is already done
+	_, _, _, _, err = SecantCtx(ctx, y, 1, 2, 1e-12, 1e-12, 50, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the secant method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	_, _, _, err = BisectBolzanoCtx(ctx, y, 1, 2, 1e-12, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the bisection method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	_, _, _, _, _, err = FixPtCtx(ctx, y, 1.5, 10, 50, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the fixed point method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+
+	t.Logf("testing evaluation budget")
+	// the newton method needs 2i+3 evaluations of y and dy to converge at (zero based) iteration i
+	zero, _, _, i, err := NewtonRaphsonCtx(context.Background(), y, dy, 1.5, 1e-12, 1e-12, 50, 0)
+	if err != nil || math.Abs(zero-1.365230013414097) > 1e-12 {
+		t.Errorf("unexpected result for the newton method. %f, %v", zero, err)
+	}
+	_, _, _, _, err = NewtonRaphsonCtx(context.Background(), y, dy, 1.5, 1e-12, 1e-12, 50, 2*i+2)
+	if err != ErrMaxEval {
+		t.Errorf("wrong error for the newton method. expecting: %v, receiving %v", ErrMaxEval, err)
+	}
+	_, _, _, _, err = NewtonRaphsonCtx(context.Background(), y, dy, 1.5, 1e-12, 1e-12, 50, 2*i+3)
+	if err != nil {
+		t.Errorf("unexpected error for the newton method. %v", err)
+	}
+	evals = 0
+	yCount := func(x float64) float64 {
+		evals++
+		return y(x)
+	}
+	_, _, _, _, err = SecantCtx(context.Background(), yCount, 1, 2, 1e-12, 1e-12, 50, 4)
+	if err != ErrMaxEval || evals != 4 {
+		t.Errorf("wrong budget for the secant method. expecting: %v after 4 evaluations, receiving %v after %d", ErrMaxEval, err, evals)
+	}
+
+	t.Logf("testing muller method")
+	z := func(z complex128) complex128 {
+		return z*z*z*z + 1
+	}
+	_, _, _, _, err = MullerCtx(ctx, z, 0.5, 1, 1.5, 1e-12, 1e-12, 50, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the muller method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	// the three initial points are evaluated before the first iteration
+	_, _, _, _, err = MullerCtx(context.Background(), z, 0.5, 1, 1.5, 1e-12, 1e-12, 50, 3)
+	if err != ErrMaxEval {
+		t.Errorf("wrong error for the muller method. expecting: %v, receiving %v", ErrMaxEval, err)
+	}
+}
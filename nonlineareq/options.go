@@ -0,0 +1,320 @@
+package nonlineareq
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// Options holds the stopping criteria shared by the root finders. A zero tolerance disables its criterion, so each method
+// stops as soon as any of the enabled criteria is met
+type Options struct {
+	AbsTol  float64 // tolerance for the absolute error (last step or bracket width)
+	RelTol  float64 // tolerance for the relative error
+	FuncTol float64 // tolerance for |f(x)| (|g(x) - x| for the fixed point methods)
+	MaxIter int     // maximum number of iterations
+	MaxEval int     // maximum number of evaluations of the function and its derivatives (0 for no limit)
+
+	Observer    Observer    // optional callback called at the end of every iteration
+	VecObserver VecObserver // optional callback of the finders for systems of equations (see SystemFinder)
+	ZObserver   ZObserver   // optional callback of the finders for complex functions (see MullerFinder)
+}
+
+// DefaultOptions returns the settings used when the caller has no specific requirements
+func DefaultOptions() Options {
+	return Options{
+		AbsTol:  1e-12,
+		RelTol:  1e-12,
+		FuncTol: 0,
+		MaxIter: 100,
+		MaxEval: 0,
+	}
+}
+
+// Result holds the outputs of a root finder
+type Result struct {
+	Root   float64 // approximation to the zero (or to the fixed point)
+	FRoot  float64 // function value evaluated at Root (residual g(x) - x of the last iterate for the fixed point methods)
+	AbsErr float64 // absolute error of the approximation
+	RelErr float64 // relative error of the approximation
+	Iter   int     // number of iterations performed
+	Evals  int     // number of evaluations of the function and its derivatives
+
+	Multiplicity int // estimated multiplicity of the zero (0 if the method does not estimate it)
+}
+
+// Iteration describes the state of a root finder at the end of an iteration
+type Iteration struct {
+	Iter      int     // iteration number, starting at 1
+	X         float64 // current approximation to the zero
+	FX        float64 // function value evaluated at X (residual g(x) - x for the fixed point methods)
+	A, B      float64 // extreme values of the current bracket, only set by the bracketing methods
+	Bracketed bool    // A and B bracket the zero
+	AbsErr    float64 // absolute error estimate
+	RelErr    float64 // relative error estimate
+}
+
+// Observer function type is used to follow the progress of a root finder, returning true stops the iteration early
+// in which case the finder returns the last approximation together with ErrStopped
+type Observer func(it Iteration) (stop bool)
+
+// Recorder collects the iterations of a root finder (e.g. for convergence plots or regression tests)
+// Its Observe method is used as the Observer of the settings
+type Recorder struct {
+	Trace []Iteration
+}
+
+// Observe appends the iteration to the trace, it never stops the iteration
+func (r *Recorder) Observe(it Iteration) (stop bool) {
+	r.Trace = append(r.Trace, it)
+	return false
+}
+
+// Estimates returns the approximations to the zero of the recorded iterations
+func (r *Recorder) Estimates() []float64 {
+	x := make([]float64, len(r.Trace))
+	for k, it := range r.Trace {
+		x[k] = it.X
+	}
+	return x
+}
+
+// VecResult holds the outputs of a finder for systems of equations
+type VecResult struct {
+	Root   []float64 // approximation to the zero (or to the fixed point)
+	FRoot  []float64 // function value evaluated at Root (last step G(P) - P for the fixed point methods)
+	AbsErr float64   // absolute error of the approximation (norm of the last step)
+	RelErr float64   // relative error of the approximation
+	Iter   int       // number of iterations performed
+	Evals  int       // number of evaluations of the function and its Jacobian matrix
+}
+
+// VecIteration describes the state of a finder for systems of equations at the end of an iteration
+type VecIteration struct {
+	Iter   int       // iteration number, starting at 1
+	X      []float64 // current approximation to the zero
+	FX     []float64 // function value evaluated at X (last step G(P) - P for the fixed point methods)
+	AbsErr float64   // absolute error estimate
+	RelErr float64   // relative error estimate
+}
+
+// VecObserver function type is the Observer of the finders for systems of equations
+type VecObserver func(it VecIteration) (stop bool)
+
+// VecRecorder collects the iterations of a finder for systems of equations, its Observe method is used as the
+// VecObserver of the settings
+type VecRecorder struct {
+	Trace []VecIteration
+}
+
+// Observe appends the iteration to the trace, it never stops the iteration
+func (r *VecRecorder) Observe(it VecIteration) (stop bool) {
+	r.Trace = append(r.Trace, it)
+	return false
+}
+
+// ZResult holds the outputs of a finder for complex functions
+type ZResult struct {
+	Root   complex128 // approximation to the zero
+	FRoot  complex128 // function value evaluated at Root
+	AbsErr float64    // absolute error of the approximation
+	RelErr float64    // relative error of the approximation
+	Iter   int        // number of iterations performed
+	Evals  int        // number of evaluations of the function
+}
+
+// ZIteration describes the state of a finder for complex functions at the end of an iteration
+type ZIteration struct {
+	Iter   int        // iteration number, starting at 1
+	Z      complex128 // current approximation to the zero
+	FZ     complex128 // function value evaluated at Z
+	AbsErr float64    // absolute error estimate
+	RelErr float64    // relative error estimate
+}
+
+// ZObserver function type is the Observer of the finders for complex functions
+type ZObserver func(it ZIteration) (stop bool)
+
+// ZRecorder collects the iterations of a finder for complex functions, its Observe method is used as the ZObserver of
+// the settings
+type ZRecorder struct {
+	Trace []ZIteration
+}
+
+// Observe appends the iteration to the trace, it never stops the iteration
+func (r *ZRecorder) Observe(it ZIteration) (stop bool) {
+	r.Trace = append(r.Trace, it)
+	return false
+}
+
+// RootFinder is implemented by every scalar root finding method of the package, so the method can be selected at runtime
+// The method specific inputs (initial points, brackets, derivatives) are the fields of the implementing type
+type RootFinder interface {
+	FindRoot(y YEqFuncx, opts Options) (Result, error)
+}
+
+// FindRootCtx runs the root finder with a context, which is checked before every evaluation of the function
+// The wrapped ctx.Err() is returned when the context is done before the finder converges
+func FindRootCtx(ctx context.Context, finder RootFinder, y YEqFuncx, opts Options) (Result, error) {
+	s := newSolver(ctx, y, opts)
+	m, ok := finder.(solverMethod)
+	if !ok {
+		// the finder is not part of the package, the context can only be checked before starting
+		if err := s.interrupted(); err != nil {
+			return s.fail(0, err)
+		}
+		return finder.FindRoot(y, opts)
+	}
+	return m.solve(s)
+}
+
+// solverMethod is implemented by the root finders of the package, which run on a solver that checks the context
+type solverMethod interface {
+	solve(s *solver) (Result, error)
+}
+
+// solver holds the state shared by the root finders during a single solve
+type solver struct {
+	ctx   context.Context
+	y     YEqFuncx
+	opts  Options
+	evals int
+}
+
+// newSolver prepares the solve of y with the given settings
+func newSolver(ctx context.Context, y YEqFuncx, opts Options) *solver {
+	return &solver{ctx: ctx, y: y, opts: opts}
+}
+
+// eval evaluates the function at x
+func (s *solver) eval(x float64) (float64, error) {
+	return s.call(s.y, x)
+}
+
+// call evaluates fn (the function or one of its derivatives) at x, enforcing the evaluation budget and checking the context
+func (s *solver) call(fn YEqFuncx, x float64) (float64, error) {
+	if err := s.spend(); err != nil {
+		return math.NaN(), err
+	}
+	return fn(x), nil
+}
+
+// spend checks the context and counts one evaluation, returning ErrMaxEval when the budget is exhausted
+func (s *solver) spend() error {
+	if err := s.interrupted(); err != nil {
+		return err
+	}
+	if s.opts.MaxEval > 0 && s.evals >= s.opts.MaxEval {
+		return ErrMaxEval
+	}
+	s.evals++
+	return nil
+}
+
+// interrupted returns the wrapped ctx.Err() if the context of the solve is done
+func (s *solver) interrupted() error {
+	if err := s.ctx.Err(); err != nil {
+		return fmt.Errorf("root finder interrupted: %w", err)
+	}
+	return nil
+}
+
+// converged checks the stopping criteria of the settings
+func (s *solver) converged(absErr, relErr, fx float64) bool {
+	return (absErr < s.opts.AbsTol) || (relErr < s.opts.RelTol) || (math.Abs(fx) < s.opts.FuncTol)
+}
+
+// result packs the outputs of a successful solve
+func (s *solver) result(root, fRoot, absErr, relErr float64, iter int) (Result, error) {
+	return Result{Root: root, FRoot: fRoot, AbsErr: absErr, RelErr: relErr, Iter: iter, Evals: s.evals}, nil
+}
+
+// fail packs the outputs of a failed solve
+func (s *solver) fail(iter int, err error) (Result, error) {
+	return Result{Root: math.NaN(), FRoot: math.NaN(), AbsErr: math.NaN(), RelErr: math.NaN(), Iter: iter, Evals: s.evals}, err
+}
+
+// observe reports the iteration to the observer of the settings, returning true if the iteration must stop
+func (s *solver) observe(it Iteration) bool {
+	return s.opts.Observer != nil && s.opts.Observer(it)
+}
+
+// stopped packs the outputs of a solve stopped by the observer
+func (s *solver) stopped(it Iteration) (Result, error) {
+	return Result{Root: it.X, FRoot: it.FX, AbsErr: it.AbsErr, RelErr: it.RelErr, Iter: it.Iter, Evals: s.evals}, ErrStopped
+}
+
+// vecCall evaluates the vector function f at x, enforcing the evaluation budget and checking the context
+func (s *solver) vecCall(f VecFuncx, x []float64) ([]float64, error) {
+	if err := s.spend(); err != nil {
+		return nil, err
+	}
+	return f(x), nil
+}
+
+// vecResult packs the outputs of a successful solve of a system of equations
+func (s *solver) vecResult(root, fRoot []float64, absErr, relErr float64, iter int) (VecResult, error) {
+	return VecResult{Root: root, FRoot: fRoot, AbsErr: absErr, RelErr: relErr, Iter: iter, Evals: s.evals}, nil
+}
+
+// vecFail packs the outputs of a failed solve of a system of equations
+func (s *solver) vecFail(iter int, err error) (VecResult, error) {
+	return VecResult{AbsErr: math.NaN(), RelErr: math.NaN(), Iter: iter, Evals: s.evals}, err
+}
+
+// vecObserve reports the iteration to the VecObserver of the settings, returning true if the iteration must stop
+func (s *solver) vecObserve(it VecIteration) bool {
+	return s.opts.VecObserver != nil && s.opts.VecObserver(it)
+}
+
+// vecStopped packs the outputs of a solve of a system of equations stopped by the observer
+func (s *solver) vecStopped(it VecIteration) (VecResult, error) {
+	return VecResult{Root: it.X, FRoot: it.FX, AbsErr: it.AbsErr, RelErr: it.RelErr, Iter: it.Iter, Evals: s.evals}, ErrStopped
+}
+
+// zCall evaluates the complex function y at z, enforcing the evaluation budget and checking the context
+func (s *solver) zCall(y ZEqFuncz, z complex128) (complex128, error) {
+	if err := s.spend(); err != nil {
+		return cmplx.NaN(), err
+	}
+	return y(z), nil
+}
+
+// zResult packs the outputs of a successful solve of a complex function
+func (s *solver) zResult(root, fRoot complex128, absErr, relErr float64, iter int) (ZResult, error) {
+	return ZResult{Root: root, FRoot: fRoot, AbsErr: absErr, RelErr: relErr, Iter: iter, Evals: s.evals}, nil
+}
+
+// zFail packs the outputs of a failed solve of a complex function
+func (s *solver) zFail(iter int, err error) (ZResult, error) {
+	return ZResult{Root: cmplx.NaN(), FRoot: cmplx.NaN(), AbsErr: math.NaN(), RelErr: math.NaN(), Iter: iter, Evals: s.evals}, err
+}
+
+// zObserve reports the iteration to the ZObserver of the settings, returning true if the iteration must stop
+func (s *solver) zObserve(it ZIteration) bool {
+	return s.opts.ZObserver != nil && s.opts.ZObserver(it)
+}
+
+// zStopped packs the outputs of a solve of a complex function stopped by the observer
+func (s *solver) zStopped(it ZIteration) (ZResult, error) {
+	return ZResult{Root: it.Z, FRoot: it.FZ, AbsErr: it.AbsErr, RelErr: it.RelErr, Iter: it.Iter, Evals: s.evals}, ErrStopped
+}
+
+// relative returns the relative error of an approximation x with absolute error absErr
+func relative(absErr, x float64) float64 {
+	return absErr / (math.Abs(x) + (math.Nextafter(1, 2) - 1))
+}
+
+// toleranceOptions maps the delta/epsilon/maxIter/maxEval arguments shared by the open methods into settings
+func toleranceOptions(delta, epsilon float64, maxIter, maxEval int) Options {
+	return Options{AbsTol: delta, RelTol: delta, FuncTol: epsilon, MaxIter: maxIter, MaxEval: maxEval}
+}
+
+// iterIndex converts the number of iterations of a Result into the zero based iteration returned by the Newton-Raphson family
+func iterIndex(iter int) int {
+	if iter > 0 {
+		return iter - 1
+	}
+	return 0
+}
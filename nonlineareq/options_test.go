@@ -0,0 +1,177 @@
+package nonlineareq
+
+import (
+	"math"
+	"testing"
+)
+
+type testStructRootFinder struct {
+	FinderName string
+	Finder     RootFinder
+	Fixed      bool // the finder solves x = g(x)
+}
+
+// rootFinderCases returns every RootFinder of the package set up for ex. 2.2 x^3 + 4x^2 - 10 = 0, which is also written
+// as the fixed point problem x = sqrt(10/(4+x))
+func rootFinderCases() (y, g YEqFuncx, expected float64, finders []testStructRootFinder) {
+	y = func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+	dy := func(x float64) float64 {
+		return 3*math.Pow(x, 2) + 8*x
+	}
+	d2y := func(x float64) float64 {
+		return 6*x + 8
+	}
+	g = func(x float64) float64 {
+		return math.Sqrt(10 / (4 + x))
+	}
+	expected = 1.365230013414097
+
+	// every method implements RootFinder, so the method can be selected at runtime
+	finders = []testStructRootFinder{
+		{FinderName: "FixPt", Finder: FixPtFinder{P0: 1.5}, Fixed: true},
+		{FinderName: "Steffensen", Finder: SteffensenFinder{P0: 1.5}, Fixed: true},
+		{FinderName: "Bisect", Finder: BisectFinder{A: 1, B: 2}},
+		{FinderName: "Brent", Finder: BrentFinder{A: 1, B: 2}},
+		{FinderName: "Ridders", Finder: RiddersFinder{A: 1, B: 2}},
+		{FinderName: "ITP", Finder: ITPFinder{A: 1, B: 2}},
+		{FinderName: "RegulaFalsi", Finder: RegulaFalsiFinder{A: 1, B: 2}},
+		{FinderName: "RegulaFalsiMod", Finder: RegulaFalsiModFinder{A: 1, B: 2, Variant: Pegasus}},
+		{FinderName: "Newton", Finder: NewtonFinder{DY: dy, P0: 1.5}},
+		{FinderName: "NewtonDamped", Finder: NewtonDampedFinder{DY: dy, P0: 1.5, MaxHalvings: 10}},
+		{FinderName: "NewtonMultiple", Finder: NewtonMultipleFinder{DY: dy, P0: 1.5}},
+		{FinderName: "NewtonBisect", Finder: NewtonBisectFinder{DY: dy, A: 1, B: 2}},
+		{FinderName: "Halley", Finder: HalleyFinder{DY: dy, D2Y: d2y, P0: 1.5}},
+		{FinderName: "Householder", Finder: HouseholderFinder{DYs: []YEqFuncx{dy, d2y}, P0: 1.5}},
+		{FinderName: "Secant", Finder: SecantFinder{P0: 1, P1: 2}},
+	}
+	return y, g, expected, finders
+}
+
+func TestRootFinder(t *testing.T) {
+	y, g, expected, finders := rootFinderCases()
+	for _, tc := range finders {
+		t.Logf("testing case number: %s", tc.FinderName)
+		fn := y
+		if tc.Fixed {
+			fn = g
+		}
+		evals := 0
+		counted := func(x float64) float64 {
+			evals++
+			return fn(x)
+		}
+		res, err := tc.Finder.FindRoot(counted, DefaultOptions())
+		if err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.FinderName, err)
+			continue
+		}
+		if math.Abs(res.Root-expected) > 1e-10 {
+			t.Errorf("wrong estimation for case: %s. expecting: %f, receiving %f", tc.FinderName, expected, res.Root)
+		}
+		if res.Iter < 1 || res.Evals < evals {
+			t.Errorf("wrong counters for case: %s. iterations: %d, evaluations: %d (%d of the function)", tc.FinderName, res.Iter, res.Evals, evals)
+		}
+		t.Logf("testing case number: %s OK", tc.FinderName)
+
+		t.Logf("testing error signals case number: %s", tc.FinderName)
+		// Test case: the evaluation budget is enforced independently of the iterations
+		opts := DefaultOptions()
+		opts.MaxEval = 4
+		res, err = tc.Finder.FindRoot(fn, opts)
+		if err != ErrMaxEval {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.FinderName, ErrMaxEval, err)
+		}
+		if res.Evals != opts.MaxEval {
+			t.Errorf("wrong evaluations for case: %s. expecting: %d, receiving %d", tc.FinderName, opts.MaxEval, res.Evals)
+		}
+		// Test case: error maximum iterations reached
+		opts = DefaultOptions()
+		opts.MaxIter = 1
+		_, err = tc.Finder.FindRoot(fn, opts)
+		if err != ErrMaxIter {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.FinderName, ErrMaxIter, err)
+		}
+		t.Logf("testing error signals case number: %s OK", tc.FinderName)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	y := func(x float64) float64 {
+		return math.Pow(x, 3) + 4*math.Pow(x, 2) - 10
+	}
+
+	t.Logf("testing function tolerance")
+	// the bisection stops as soon as |f(c)| is small enough, long before the bracket is
+	res, err := BisectFinder{A: 1, B: 2}.FindRoot(y, Options{FuncTol: 1e-3, MaxIter: 100})
+	if err != nil {
+		t.Errorf("unexpected error. %v", err)
+	} else if math.Abs(res.FRoot) >= 1e-3 || res.AbsErr < 1e-6 {
+		t.Errorf("wrong stopping criterion. f(c): %e, absolute error: %e", res.FRoot, res.AbsErr)
+	}
+
+	t.Logf("testing relative tolerance")
+	res, err = BisectFinder{A: 1000, B: 1e6}.FindRoot(func(x float64) float64 { return x - 12345.678 }, Options{RelTol: 1e-6, MaxIter: 100})
+	if err != nil {
+		t.Errorf("unexpected error. %v", err)
+	} else if res.RelErr >= 1e-6 || res.AbsErr < 1e-3 {
+		t.Errorf("wrong stopping criterion. relative error: %e, absolute error: %e", res.RelErr, res.AbsErr)
+	}
+
+	t.Logf("testing invalid tolerance")
+	_, err = ITPFinder{A: 1, B: 2}.FindRoot(y, Options{RelTol: 1e-6, MaxIter: 100})
+	if err != ErrInvalidTol {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrInvalidTol, err)
+	}
+}
+
+func TestObserver(t *testing.T) {
+	y, g, _, finders := rootFinderCases()
+	for _, tc := range finders {
+		t.Logf("testing case number: %s", tc.FinderName)
+		fn := y
+		if tc.Fixed {
+			fn = g
+		}
+		// Test case: the recorder collects every iteration
+		rec := &Recorder{}
+		opts := DefaultOptions()
+		opts.Observer = rec.Observe
+		res, err := tc.Finder.FindRoot(fn, opts)
+		if err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.FinderName, err)
+			continue
+		}
+		if len(rec.Trace) != res.Iter {
+			t.Errorf("wrong trace length for case: %s. expecting: %d, receiving %d", tc.FinderName, res.Iter, len(rec.Trace))
+		}
+		for k, it := range rec.Trace {
+			if it.Iter != k+1 {
+				t.Errorf("wrong iteration number for case: %s. expecting: %d, receiving %d", tc.FinderName, k+1, it.Iter)
+			}
+			if it.Bracketed && (it.X < it.A || it.X > it.B || it.B-it.A > 1) {
+				t.Errorf("wrong bracket for case: %s, iteration %d. x: %f, bracket [%f, %f]", tc.FinderName, it.Iter, it.X, it.A, it.B)
+			}
+		}
+		// the bisection based methods return the midpoint of the last bracket instead of the last estimate
+		if x := rec.Estimates(); len(x) == 0 || math.Abs(x[len(x)-1]-res.Root) > res.AbsErr {
+			t.Errorf("wrong last iteration for case: %s. expecting: %f, receiving %v", tc.FinderName, res.Root, x)
+		}
+		t.Logf("testing case number: %s OK", tc.FinderName)
+
+		t.Logf("testing error signals case number: %s", tc.FinderName)
+		// Test case: the observer stops the iteration early
+		opts.Observer = func(it Iteration) bool {
+			return it.Iter == 2
+		}
+		res, err = tc.Finder.FindRoot(fn, opts)
+		if err != ErrStopped {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.FinderName, ErrStopped, err)
+		}
+		if res.Iter != 2 || math.IsNaN(res.Root) {
+			t.Errorf("wrong result for case: %s. expecting the second iteration, receiving %d (%f)", tc.FinderName, res.Iter, res.Root)
+		}
+		t.Logf("testing error signals case number: %s OK", tc.FinderName)
+	}
+}
@@ -0,0 +1,473 @@
+package nonlineareq
+
+import (
+	"context"
+	"math"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+)
+
+// VecFuncx function type is used to create Y=F(X) type of vector functions (i.e. systems of equations F(X)=0)
+type VecFuncx func(x []float64) []float64
+
+// JacFuncx function type is used to create the Jacobian matrix of a VecFuncx, where J[i][j] = dF_i/dx_j
+type JacFuncx func(x []float64) [][]float64
+
+// SystemFinder is implemented by the methods for systems of equations of the package, so the method can be selected at
+// runtime. The iterations are reported to the VecObserver of the settings, FuncTol is the tolerance for ||F(P)||
+type SystemFinder interface {
+	FindRoot(f VecFuncx, opts Options) (VecResult, error)
+}
+
+// FindSystemRootCtx runs the finder for systems of equations with a context, which is checked before every evaluation of
+// the function and its Jacobian matrix (see FindRootCtx)
+func FindSystemRootCtx(ctx context.Context, finder SystemFinder, f VecFuncx, opts Options) (VecResult, error) {
+	s := newSolver(ctx, nil, opts)
+	m, ok := finder.(systemMethod)
+	if !ok {
+		// the finder is not part of the package, the context can only be checked before starting
+		if err := s.interrupted(); err != nil {
+			return s.vecFail(0, err)
+		}
+		return finder.FindRoot(f, opts)
+	}
+	return m.solveSystem(s, f)
+}
+
+// systemMethod is implemented by the finders for systems of equations of the package, which run on a solver that checks
+// the context
+type systemMethod interface {
+	solveSystem(s *solver, f VecFuncx) (VecResult, error)
+}
+
+// NewtonSystemFinder implements SystemFinder with the Newton-Raphson Method for systems of nonlinear equations
+type NewtonSystemFinder struct {
+	Jac JacFuncx  // Jacobian matrix of the function, if nil a forward finite difference Jacobian is used
+	P0  []float64 // initial point for the zero approximation
+}
+
+// FindRoot estimates the zero of the vector function f starting from P0
+func (m NewtonSystemFinder) FindRoot(f VecFuncx, opts Options) (VecResult, error) {
+	return m.solveSystem(newSolver(context.Background(), nil, opts), f)
+}
+
+func (m NewtonSystemFinder) solveSystem(s *solver, f VecFuncx) (VecResult, error) {
+	p := append([]float64(nil), m.P0...)
+	yP, err := s.vecCall(f, p)
+	if err != nil {
+		return s.vecFail(0, err)
+	}
+	var jacP [][]float64
+	var dp []float64
+	for i := 0; i < s.opts.MaxIter; i++ {
+		if jacP, err = s.jacobian(f, m.Jac, p, yP); err != nil {
+			return s.vecFail(i, err)
+		}
+		negY := make([]float64, len(yP))
+		for k := range yP {
+			negY[k] = -yP[k]
+		}
+		if dp, err = matrix.MatrixSolve(jacP, negY); err != nil {
+			return s.vecFail(i, err)
+		}
+		pNew := make([]float64, len(p))
+		for k := range p {
+			pNew[k] = p[k] + dp[k]
+		}
+		p = pNew
+		absErr := matrix.VectNorm(dp, matrix.NormTwo)
+		relErr := 2 * absErr / (matrix.VectNorm(p, matrix.NormTwo) + s.opts.AbsTol)
+		if yP, err = s.vecCall(f, p); err != nil {
+			return s.vecFail(i, err)
+		}
+		it := VecIteration{Iter: i + 1, X: p, FX: yP, AbsErr: absErr, RelErr: relErr}
+		if s.vecObserve(it) {
+			return s.vecStopped(it)
+		}
+		if s.converged(absErr, relErr, matrix.VectNorm(yP, matrix.NormTwo)) {
+			return s.vecResult(p, yP, absErr, relErr, i+1)
+		}
+	}
+	return s.vecFail(s.opts.MaxIter, ErrMaxIter)
+}
+
+// NewtonSystem estimates the value of X that makes the vector function equal to 0 using the Newton-Raphson Method for
+// systems of nonlinear equations. The linear system J(P) * dP = -F(P) is solved in every iteration
+// Inputs:
+//
+//		f is the vector function Y=F(X)
+//		jac is the Jacobian matrix of the function f, if nil a forward finite difference Jacobian is used
+//		p0 is the initial point for the zero approximation
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for ||F(P)||
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P)
+//	yZero is the function value evaluated at P
+//	absErr is the error of the approximation (euclidean norm of the last step)
+//	i is the iteration that generated the approximation
+func NewtonSystem(f VecFuncx, jac JacFuncx, p0 []float64, delta, epsilon float64, maxIter int) (zeroApr, yZero []float64, absErr float64, i int, err error) {
+	return NewtonSystemCtx(context.Background(), f, jac, p0, delta, epsilon, maxIter, 0)
+}
+
+// NewtonSystemCtx is like NewtonSystem but checks ctx before every evaluation (see FindSystemRootCtx) and stops with
+// ErrMaxEval after maxEval evaluations of the function and its Jacobian matrix (0 for no limit). Every column of the
+// finite difference Jacobian counts as one evaluation
+func NewtonSystemCtx(ctx context.Context, f VecFuncx, jac JacFuncx, p0 []float64, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero []float64, absErr float64, i int, err error) {
+	res, err := FindSystemRootCtx(ctx, NewtonSystemFinder{Jac: jac, P0: p0}, f, toleranceOptions(delta, epsilon, maxIter, maxEval))
+	if err != nil {
+		return nil, nil, math.NaN(), res.Iter, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), nil
+}
+
+// jacobian evaluates the Jacobian matrix jac at x (one evaluation), or the forward finite difference Jacobian of f when
+// jac is nil (one evaluation of f for every component of x)
+func (s *solver) jacobian(f VecFuncx, jac JacFuncx, x, fx []float64) ([][]float64, error) {
+	if jac != nil {
+		if err := s.spend(); err != nil {
+			return nil, err
+		}
+		return jac(x), nil
+	}
+	for range x {
+		if err := s.spend(); err != nil {
+			return nil, err
+		}
+	}
+	return jacobianFD(f, x, fx), nil
+}
+
+// jacobianFD estimates the Jacobian matrix of f at x using forward finite differences
+// fx is the value of f(x), which is reused to save one evaluation of the function
+func jacobianFD(f VecFuncx, x, fx []float64) (jac [][]float64) {
+	sqrtEps := math.Sqrt(math.Nextafter(1, 2) - 1)
+	jac = make([][]float64, len(fx))
+	for i := range jac {
+		jac[i] = make([]float64, len(x))
+	}
+	xh := append([]float64(nil), x...)
+	for j := range x {
+		h := sqrtEps * math.Max(math.Abs(x[j]), 1)
+		xh[j] = x[j] + h
+		// use the representable step to reduce the rounding error
+		h = xh[j] - x[j]
+		fxh := f(xh)
+		for i := range fx {
+			jac[i][j] = (fxh[i] - fx[i]) / h
+		}
+		xh[j] = x[j]
+	}
+	return jac
+}
+
+// BroydenMethod selects the rank-one update applied by Broyden
+type BroydenMethod int
+
+const (
+	BroydenGood BroydenMethod = iota // updates the approximation of the Jacobian matrix
+	BroydenBad                       // updates the approximation of the inverse of the Jacobian matrix
+)
+
+// BroydenFinder implements SystemFinder with the Broyden's quasi-Newton Method
+type BroydenFinder struct {
+	P0      []float64     // initial point for the zero approximation
+	Method  BroydenMethod // rank-one update (BroydenGood or BroydenBad)
+	Restart bool          // recompute the finite difference Jacobian when ||F(P)|| does not decrease
+}
+
+// FindRoot estimates the zero of the vector function f starting from P0
+func (m BroydenFinder) FindRoot(f VecFuncx, opts Options) (VecResult, error) {
+	return m.solveSystem(newSolver(context.Background(), nil, opts), f)
+}
+
+func (m BroydenFinder) solveSystem(s *solver, f VecFuncx) (VecResult, error) {
+	res, _, err := broyden(s, f, m)
+	return res, err
+}
+
+// broyden runs the Broyden's Method set up by m and also returns the iterations
+func broyden(s *solver, f VecFuncx, m BroydenFinder) (res VecResult, pSeries [][]float64, err error) {
+	p := append([]float64(nil), m.P0...)
+	yP, err := s.vecCall(f, p)
+	if err != nil {
+		res, err = s.vecFail(0, err)
+		return res, nil, err
+	}
+	pSeries = append(pSeries, p)
+	// approx is the Jacobian (good method) or its inverse (bad method)
+	approx, err := s.broydenInit(f, p, yP, m.Method)
+	if err != nil {
+		res, err = s.vecFail(0, err)
+		return res, nil, err
+	}
+	var dp, yNew []float64
+	for i := 0; i < s.opts.MaxIter; i++ {
+		negY := make([]float64, len(yP))
+		for k := range yP {
+			negY[k] = -yP[k]
+		}
+		if m.Method == BroydenBad {
+			dp, err = matrix.MatrixVectMult(approx, negY)
+		} else {
+			dp, err = matrix.MatrixSolve(approx, negY)
+		}
+		if err != nil {
+			res, err = s.vecFail(i, err)
+			return res, nil, err
+		}
+		pNew := make([]float64, len(p))
+		for k := range p {
+			pNew[k] = p[k] + dp[k]
+		}
+		if yNew, err = s.vecCall(f, pNew); err != nil {
+			res, err = s.vecFail(i, err)
+			return res, nil, err
+		}
+		pSeries = append(pSeries, pNew)
+		absErr := matrix.VectNorm(dp, matrix.NormTwo)
+		relErr := 2 * absErr / (matrix.VectNorm(pNew, matrix.NormTwo) + s.opts.AbsTol)
+		normYNew := matrix.VectNorm(yNew, matrix.NormTwo)
+		it := VecIteration{Iter: i + 1, X: pNew, FX: yNew, AbsErr: absErr, RelErr: relErr}
+		if s.vecObserve(it) {
+			res, err = s.vecStopped(it)
+			return res, pSeries, err
+		}
+		if s.converged(absErr, relErr, normYNew) {
+			res, err = s.vecResult(pNew, yNew, absErr, relErr, i+1)
+			return res, pSeries, err
+		}
+		if m.Restart && !(normYNew < matrix.VectNorm(yP, matrix.NormTwo)) {
+			// no progress, start again from a finite difference Jacobian
+			approx, err = s.broydenInit(f, pNew, yNew, m.Method)
+		} else {
+			dy := make([]float64, len(yP))
+			for k := range yP {
+				dy[k] = yNew[k] - yP[k]
+			}
+			approx, err = broydenUpdate(approx, dp, dy, m.Method)
+		}
+		if err != nil {
+			res, err = s.vecFail(i, err)
+			return res, nil, err
+		}
+		p = pNew
+		yP = yNew
+	}
+	res, err = s.vecFail(s.opts.MaxIter, ErrMaxIter)
+	return res, nil, err
+}
+
+// Broyden estimates the value of X that makes the vector function equal to 0 using the Broyden's quasi-Newton Method
+// The Jacobian matrix (or its inverse) is approximated with finite differences at the initial point and then corrected
+// with rank-one updates, so the function is evaluated only once per iteration
+// Inputs:
+//
+//		f is the vector function Y=F(X)
+//		p0 is the initial point for the zero approximation
+//		method is the rank-one update (BroydenGood or BroydenBad)
+//		restart recomputes the finite difference Jacobian when ||F(P)|| does not decrease
+//		delta is the tolerance for the zero
+//		epsilon is the tolerance for ||F(P)||
+//	 maxIter is the maximum iteration for the algorithm
+//
+// Outputs:
+//
+//	zeroApr is the approximation to the zero (P)
+//	yZero is the function value evaluated at P
+//	absErr is the error of the approximation (euclidean norm of the last step)
+//	i is the iteration that generated the approximation
+//	pSeries are the iterations of the algorithm (starting with p0)
+func Broyden(f VecFuncx, p0 []float64, method BroydenMethod, restart bool, delta, epsilon float64, maxIter int) (zeroApr, yZero []float64, absErr float64, i int, pSeries [][]float64, err error) {
+	return BroydenCtx(context.Background(), f, p0, method, restart, delta, epsilon, maxIter, 0)
+}
+
+// BroydenCtx is like Broyden but checks ctx before every evaluation (see FindSystemRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function (0 for no limit). Every column of the finite difference Jacobian counts as
+// one evaluation
+func BroydenCtx(ctx context.Context, f VecFuncx, p0 []float64, method BroydenMethod, restart bool, delta, epsilon float64, maxIter, maxEval int) (zeroApr, yZero []float64, absErr float64, i int, pSeries [][]float64, err error) {
+	res, pSeries, err := broyden(newSolver(ctx, nil, toleranceOptions(delta, epsilon, maxIter, maxEval)), f, BroydenFinder{P0: p0, Method: method, Restart: restart})
+	if err != nil {
+		return nil, nil, math.NaN(), res.Iter, nil, err
+	}
+	return res.Root, res.FRoot, res.AbsErr, iterIndex(res.Iter), pSeries, nil
+}
+
+// broydenInit returns the finite difference Jacobian of f at x (good method) or its inverse (bad method)
+func (s *solver) broydenInit(f VecFuncx, x, fx []float64, method BroydenMethod) ([][]float64, error) {
+	jac, err := s.jacobian(f, nil, x, fx)
+	if err != nil {
+		return nil, err
+	}
+	if method == BroydenBad {
+		return matrix.MatrixInverse(jac)
+	}
+	return jac, nil
+}
+
+// broydenUpdate applies the rank-one update to the Jacobian approximation b (good method):
+//
+//	b + ((dy - b*dx) * transpose(dx)) / (transpose(dx) * dx)
+//
+// or to the inverse Jacobian approximation h (bad method):
+//
+//	h + ((dx - h*dy) * transpose(dy)) / (transpose(dy) * dy)
+func broydenUpdate(approx [][]float64, dx, dy []float64, method BroydenMethod) ([][]float64, error) {
+	u, v := dy, dx
+	if method == BroydenBad {
+		u, v = dx, dy
+	}
+	vv, err := matrix.VectScalMult(v, v)
+	if err != nil {
+		return nil, err
+	}
+	if vv == 0 {
+		return nil, ErrZeroDenom
+	}
+	av, err := matrix.MatrixVectMult(approx, v)
+	if err != nil {
+		return nil, err
+	}
+	for k := range av {
+		av[k] = (u[k] - av[k]) / vv
+	}
+	return matrix.MatrixAdd(approx, matrix.VectOuterProd(av, v))
+}
+
+// FixPtVecFinder implements SystemFinder with the P(n+1) = G(P(n)) iteration accelerated with Anderson mixing, where
+// the function f is the iteration function G
+type FixPtVecFinder struct {
+	P0    []float64       // starting point
+	Depth int             // number of previous iterations used by Anderson mixing (0 for the plain fixed point iteration)
+	Norm  matrix.NormType // vector norm used to measure the errors
+}
+
+// FindRoot estimates the fixed point of the iteration function f
+func (m FixPtVecFinder) FindRoot(f VecFuncx, opts Options) (VecResult, error) {
+	return m.solveSystem(newSolver(context.Background(), nil, opts), f)
+}
+
+func (m FixPtVecFinder) solveSystem(s *solver, f VecFuncx) (VecResult, error) {
+	res, _, err := fixPtVec(s, f, m)
+	return res, err
+}
+
+// fixPtVec runs the fixed point iteration set up by m and also returns the iterations
+func fixPtVec(s *solver, y VecFuncx, m FixPtVecFinder) (res VecResult, pSeries [][]float64, err error) {
+	p := append([]float64(nil), m.P0...)
+	pSeries = append(pSeries, p)
+	gP, err := s.vecCall(y, p)
+	if err != nil {
+		res, err = s.vecFail(0, err)
+		return res, nil, err
+	}
+	resP := vectSub(gP, p)
+	// differences of the residuals and of the iteration function values, used by Anderson mixing
+	var dRes, dG [][]float64
+	var gNew []float64
+	for i := 1; i <= s.opts.MaxIter; i++ {
+		pAprox := append([]float64(nil), gP...)
+		if m.Depth > 0 && len(dRes) > 0 {
+			gamma, errMix := andersonCoef(dRes, resP)
+			if errMix == nil {
+				for j := range gamma {
+					for k := range pAprox {
+						pAprox[k] -= gamma[j] * dG[j][k]
+					}
+				}
+			} else {
+				// the residual differences are linearly dependent, restart the mixing history
+				dRes, dG = nil, nil
+			}
+		}
+		pSeries = append(pSeries, pAprox)
+		step := vectSub(pAprox, p)
+		errAprox := matrix.VectNorm(step, m.Norm)
+		relErr := relative(errAprox, matrix.VectNorm(pAprox, m.Norm))
+		it := VecIteration{Iter: i, X: pAprox, FX: step, AbsErr: errAprox, RelErr: relErr}
+		if s.vecObserve(it) {
+			res, err = s.vecStopped(it)
+			return res, pSeries, err
+		}
+		if s.converged(errAprox, relErr, errAprox) {
+			res, err = s.vecResult(pAprox, step, errAprox, relErr, i)
+			return res, pSeries, err
+		}
+		if gNew, err = s.vecCall(y, pAprox); err != nil {
+			res, err = s.vecFail(i, err)
+			return res, nil, err
+		}
+		resNew := vectSub(gNew, pAprox)
+		if m.Depth > 0 {
+			dRes = append(dRes, vectSub(resNew, resP))
+			dG = append(dG, vectSub(gNew, gP))
+			if len(dRes) > m.Depth {
+				dRes, dG = dRes[1:], dG[1:]
+			}
+		}
+		p, gP, resP = pAprox, gNew, resNew
+	}
+	res, err = s.vecFail(s.opts.MaxIter, ErrMaxIter)
+	return res, nil, err
+}
+
+// FixPtVec estimates the solution to the system of equations X = G(X) using the P(n+1) = G(P(n)) iteration, which is
+// estimated based on an initial point. The iteration can be accelerated with Anderson mixing, where the next point is the
+// combination of the last depth+1 values of G that minimizes the residual G(P) - P in the least squares sense
+// Inputs:
+//
+//	y is the iteration function
+//	p0 is the starting point
+//	depth is the number of previous iterations used by Anderson mixing (0 for the plain fixed point iteration)
+//	norm is the vector norm used to measure the errors
+//	tol is the tolerance in decimal places
+//	maxIter is the maximum number of allowed iterations
+//
+// Outputs:
+//
+//	i last iteration
+//	pAprox fixed point approximation
+//	errAprox Absolute error
+//	relErr relative error
+//	pSeries fixed point iterations
+func FixPtVec(y VecFuncx, p0 []float64, depth int, norm matrix.NormType, tol int, maxIter int) (i int, pAprox []float64, errAprox, relErr float64, pSeries [][]float64, err error) {
+	return FixPtVecCtx(context.Background(), y, p0, depth, norm, tol, maxIter, 0)
+}
+
+// FixPtVecCtx is like FixPtVec but checks ctx before every evaluation (see FindSystemRootCtx) and stops with ErrMaxEval
+// after maxEval evaluations of the function (0 for no limit)
+func FixPtVecCtx(ctx context.Context, y VecFuncx, p0 []float64, depth int, norm matrix.NormType, tol int, maxIter, maxEval int) (i int, pAprox []float64, errAprox, relErr float64, pSeries [][]float64, err error) {
+	TolDec := float64(1) / math.Pow10(tol)
+	s := newSolver(ctx, nil, Options{AbsTol: TolDec, RelTol: TolDec, MaxIter: maxIter, MaxEval: maxEval})
+	res, pSeries, err := fixPtVec(s, y, FixPtVecFinder{P0: p0, Depth: depth, Norm: norm})
+	if err != nil {
+		return 0, nil, 0, 0, nil, err
+	}
+	return res.Iter, res.Root, res.AbsErr, res.RelErr, pSeries, nil
+}
+
+// andersonCoef solves the least squares problem min ||res - dRes * gamma|| using the normal equations
+func andersonCoef(dRes [][]float64, res []float64) (gamma []float64, err error) {
+	m := len(dRes)
+	a := make([][]float64, m)
+	b := make([]float64, m)
+	for j := 0; j < m; j++ {
+		a[j] = make([]float64, m)
+		for k := 0; k < m; k++ {
+			a[j][k], _ = matrix.VectScalMult(dRes[j], dRes[k])
+		}
+		b[j], _ = matrix.VectScalMult(dRes[j], res)
+	}
+	return matrix.MatrixSolve(a, b)
+}
+
+// vectSub returns the component-wise difference a - b of two vectors of the same size
+func vectSub(a, b []float64) []float64 {
+	res := make([]float64, len(a))
+	for k := range a {
+		res[k] = a[k] - b[k]
+	}
+	return res
+}
@@ -0,0 +1,547 @@
+package nonlineareq
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+)
+
+type testStructNewtonSystem struct {
+	TestF          VecFuncx
+	TestJ          JacFuncx
+	TestCaseName   string
+	P0             []float64
+	TestDelta      float64
+	TestEpsilon    float64
+	TestMaxIter    int
+	ExpectedValueP []float64
+	ExpectedErr    error
+}
+
+func TestNewtonSystem(t *testing.T) {
+	testCases := make([]testStructNewtonSystem, 5)
+	f := func(x []float64) []float64 {
+		return []float64{
+			x[0]*x[0] - 2*x[0] - x[1] + 0.5,
+			x[0]*x[0] + 4*x[1]*x[1] - 4,
+		}
+	}
+	jac := func(x []float64) [][]float64 {
+		return [][]float64{
+			{2*x[0] - 2, -1},
+			{2 * x[0], 8 * x[1]},
+		}
+	}
+
+	testCases[0].TestF = f
+	testCases[0].TestJ = jac
+	testCases[0].TestCaseName = "ex. 3.43"
+	testCases[0].P0 = []float64{2, 0.25}
+	testCases[0].TestDelta = 1e-12
+	testCases[0].TestEpsilon = 1e-12
+	testCases[0].TestMaxIter = 20
+	testCases[0].ExpectedValueP = []float64{1.900676726367066, 0.311218565419294}
+
+	testCases[1].TestF = f
+	testCases[1].TestCaseName = "ex. 3.43 finite difference jacobian"
+	testCases[1].P0 = []float64{2, 0.25}
+	testCases[1].TestDelta = 1e-12
+	testCases[1].TestEpsilon = 1e-12
+	testCases[1].TestMaxIter = 20
+	testCases[1].ExpectedValueP = []float64{1.900676726367066, 0.311218565419294}
+
+	testCases[2].TestF = f
+	testCases[2].TestJ = jac
+	testCases[2].TestCaseName = "ex. 3.43 second solution"
+	testCases[2].P0 = []float64{0, 1}
+	testCases[2].TestDelta = 1e-12
+	testCases[2].TestEpsilon = 1e-12
+	testCases[2].TestMaxIter = 20
+	testCases[2].ExpectedValueP = []float64{-0.222214555069800, 0.993808418603578}
+
+	testCases[3].TestF = func(x []float64) []float64 {
+		return []float64{
+			3*x[0] - math.Cos(x[1]*x[2]) - 0.5,
+			x[0]*x[0] - 81*(x[1]+0.1)*(x[1]+0.1) + math.Sin(x[2]) + 1.06,
+			math.Exp(-x[0]*x[1]) + 20*x[2] + (10*math.Pi-3)/3,
+		}
+	}
+	testCases[3].TestCaseName = "3x3 system finite difference jacobian"
+	testCases[3].P0 = []float64{0.1, 0.1, -0.1}
+	testCases[3].TestDelta = 1e-12
+	testCases[3].TestEpsilon = 1e-12
+	testCases[3].TestMaxIter = 20
+	testCases[3].ExpectedValueP = []float64{0.5, 0, -math.Pi / 6}
+
+	testCases[4].TestF = f
+	testCases[4].TestJ = func(x []float64) [][]float64 {
+		return [][]float64{
+			{0, 0},
+			{0, 0},
+		}
+	}
+	testCases[4].TestCaseName = "singular jacobian"
+	testCases[4].P0 = []float64{2, 0.25}
+	testCases[4].TestDelta = 1e-12
+	testCases[4].TestEpsilon = 1e-12
+	testCases[4].TestMaxIter = 20
+	testCases[4].ExpectedErr = matrix.ErrMatSingular
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		p, yP, _, _, err := NewtonSystem(tc.TestF, tc.TestJ, tc.P0, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			for k := range tc.ExpectedValueP {
+				if math.Abs(p[k]-tc.ExpectedValueP[k]) > 1e-9 {
+					t.Errorf("wrong estimation of p for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedValueP, p)
+					break
+				}
+			}
+			if matrix.VectNorm(yP, matrix.NormTwo) > 1e-9 {
+				t.Errorf("wrong estimation of y(p) for case: %s. expecting: 0, receiving %v", tc.TestCaseName, yP)
+			}
+			t.Logf("testing error signals case number: %s", tc.TestCaseName)
+			//test case: error maximum iterations reached
+			_, _, _, _, err = NewtonSystem(tc.TestF, tc.TestJ, tc.P0, tc.TestDelta, tc.TestEpsilon, 1)
+			switch err {
+			case nil:
+				t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+			}
+			t.Logf("testing error signals case number: %s OK", tc.TestCaseName)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
+
+type testStructBroyden struct {
+	TestF          VecFuncx
+	TestCaseName   string
+	P0             []float64
+	TestMethod     BroydenMethod
+	TestRestart    bool
+	TestDelta      float64
+	TestEpsilon    float64
+	TestMaxIter    int
+	ExpectedValueP []float64
+	ExpectedErr    error
+}
+
+func TestBroyden(t *testing.T) {
+	testCases := make([]testStructBroyden, 6)
+	f := func(x []float64) []float64 {
+		return []float64{
+			x[0]*x[0] - 2*x[0] - x[1] + 0.5,
+			x[0]*x[0] + 4*x[1]*x[1] - 4,
+		}
+	}
+	g := func(x []float64) []float64 {
+		return []float64{
+			3*x[0] - math.Cos(x[1]*x[2]) - 0.5,
+			x[0]*x[0] - 81*(x[1]+0.1)*(x[1]+0.1) + math.Sin(x[2]) + 1.06,
+			math.Exp(-x[0]*x[1]) + 20*x[2] + (10*math.Pi-3)/3,
+		}
+	}
+	h := func(x []float64) []float64 {
+		return []float64{
+			x[0]*x[0] + x[1]*x[1] - 2,
+			math.Exp(x[0]-1) + x[1]*x[1]*x[1] - 2,
+		}
+	}
+
+	testCases[0].TestF = f
+	testCases[0].TestCaseName = "ex. 3.43 good method"
+	testCases[0].P0 = []float64{2, 0.25}
+	testCases[0].TestMethod = BroydenGood
+	testCases[0].TestDelta = 1e-12
+	testCases[0].TestEpsilon = 1e-12
+	testCases[0].TestMaxIter = 30
+	testCases[0].ExpectedValueP = []float64{1.900676726367066, 0.311218565419294}
+
+	testCases[1].TestF = f
+	testCases[1].TestCaseName = "ex. 3.43 bad method"
+	testCases[1].P0 = []float64{2, 0.25}
+	testCases[1].TestMethod = BroydenBad
+	testCases[1].TestDelta = 1e-12
+	testCases[1].TestEpsilon = 1e-12
+	testCases[1].TestMaxIter = 30
+	testCases[1].ExpectedValueP = []float64{1.900676726367066, 0.311218565419294}
+
+	testCases[2].TestF = g
+	testCases[2].TestCaseName = "3x3 system good method"
+	testCases[2].P0 = []float64{0.1, 0.1, -0.1}
+	testCases[2].TestMethod = BroydenGood
+	testCases[2].TestDelta = 1e-12
+	testCases[2].TestEpsilon = 1e-12
+	testCases[2].TestMaxIter = 30
+	testCases[2].ExpectedValueP = []float64{0.5, 0, -math.Pi / 6}
+
+	testCases[3].TestF = g
+	testCases[3].TestCaseName = "3x3 system bad method"
+	testCases[3].P0 = []float64{0.1, 0.1, -0.1}
+	testCases[3].TestMethod = BroydenBad
+	testCases[3].TestDelta = 1e-12
+	testCases[3].TestEpsilon = 1e-12
+	testCases[3].TestMaxIter = 30
+	testCases[3].ExpectedValueP = []float64{0.5, 0, -math.Pi / 6}
+
+	// the rank-one updates stall from this point, restarting from a finite difference Jacobian recovers the convergence
+	testCases[4].TestF = h
+	testCases[4].TestCaseName = "stalled good method without restart"
+	testCases[4].P0 = []float64{-2, -0.5}
+	testCases[4].TestMethod = BroydenGood
+	testCases[4].TestDelta = 1e-12
+	testCases[4].TestEpsilon = 1e-12
+	testCases[4].TestMaxIter = 30
+	testCases[4].ExpectedErr = ErrMaxIter
+
+	testCases[5].TestF = h
+	testCases[5].TestCaseName = "stalled good method with restart"
+	testCases[5].P0 = []float64{-2, -0.5}
+	testCases[5].TestMethod = BroydenGood
+	testCases[5].TestRestart = true
+	testCases[5].TestDelta = 1e-12
+	testCases[5].TestEpsilon = 1e-12
+	testCases[5].TestMaxIter = 30
+	testCases[5].ExpectedValueP = []float64{-0.713747411486794, 1.220886822189497}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		p, yP, _, i, pSeries, err := Broyden(tc.TestF, tc.P0, tc.TestMethod, tc.TestRestart, tc.TestDelta, tc.TestEpsilon, tc.TestMaxIter)
+		if err != tc.ExpectedErr {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedErr, err)
+		}
+		if err == nil {
+			for k := range tc.ExpectedValueP {
+				if math.Abs(p[k]-tc.ExpectedValueP[k]) > 1e-9 {
+					t.Errorf("wrong estimation of p for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedValueP, p)
+					break
+				}
+			}
+			if matrix.VectNorm(yP, matrix.NormTwo) > 1e-9 {
+				t.Errorf("wrong estimation of y(p) for case: %s. expecting: 0, receiving %v", tc.TestCaseName, yP)
+			}
+			if len(pSeries) != i+2 || pSeries[0][0] != tc.P0[0] || pSeries[len(pSeries)-1][0] != p[0] {
+				t.Errorf("wrong iteration history for case: %s. receiving %v", tc.TestCaseName, pSeries)
+			}
+			t.Logf("testing error signals case number: %s", tc.TestCaseName)
+			//test case: error maximum iterations reached
+			_, _, _, _, _, err = Broyden(tc.TestF, tc.P0, tc.TestMethod, tc.TestRestart, tc.TestDelta, tc.TestEpsilon, 2)
+			switch err {
+			case nil:
+				t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+			}
+			t.Logf("testing error signals case number: %s OK", tc.TestCaseName)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
+
+type testStructFixPtVec struct {
+	TestFunctions VecFuncx
+	TestCaseName  string
+	InitialEst    []float64
+	TestDepth     int
+	TestNorm      matrix.NormType
+	TestTol       int
+	MaxIter       int
+	ExpectedValue []float64
+	ExpectedIter  int
+}
+
+func TestFixedPointVec(t *testing.T) {
+	testCases := make([]testStructFixPtVec, 7)
+	// scalar case 2.1.3.a as a system of one equation
+	sqrtFunc := func(x []float64) []float64 {
+		return []float64{math.Sqrt(6 + x[0])}
+	}
+	// linear system with spectral radius close to 1
+	linearFunc := func(x []float64) []float64 {
+		return []float64{
+			0.9*x[0] + 0.05*x[1] + 1,
+			0.05*x[0] + 0.9*x[1] + 2,
+			0.85*x[0] + 0.1*x[2] - 1,
+		}
+	}
+	nonlinearFunc := func(x []float64) []float64 {
+		return []float64{
+			math.Cos(x[1]) / 2,
+			math.Sin(x[0])/3 + 0.5,
+		}
+	}
+
+	// without mixing the iterations must match FixPt
+	testCases[0].TestFunctions = sqrtFunc
+	testCases[0].TestCaseName = "2.1.3.a"
+	testCases[0].InitialEst = []float64{7}
+	testCases[0].TestNorm = matrix.NormTwo
+	testCases[0].TestTol = 5
+	testCases[0].MaxIter = 50
+	testCases[0].ExpectedValue = []float64{3}
+	testCases[0].ExpectedIter = 8
+
+	testCases[1].TestFunctions = sqrtFunc
+	testCases[1].TestCaseName = "2.1.3.a anderson"
+	testCases[1].InitialEst = []float64{7}
+	testCases[1].TestDepth = 1
+	testCases[1].TestNorm = matrix.NormTwo
+	testCases[1].TestTol = 5
+	testCases[1].MaxIter = 50
+	testCases[1].ExpectedValue = []float64{3}
+	testCases[1].ExpectedIter = 5
+
+	testCases[2].TestFunctions = linearFunc
+	testCases[2].TestCaseName = "linear system"
+	testCases[2].InitialEst = []float64{0, 0, 0}
+	testCases[2].TestNorm = matrix.NormInf
+	testCases[2].TestTol = 10
+	testCases[2].MaxIter = 500
+	testCases[2].ExpectedValue = []float64{80.0 / 3, 100.0 / 3, 650.0 / 27}
+	testCases[2].ExpectedIter = 390
+
+	testCases[3].TestFunctions = linearFunc
+	testCases[3].TestCaseName = "linear system anderson"
+	testCases[3].InitialEst = []float64{0, 0, 0}
+	testCases[3].TestDepth = 3
+	testCases[3].TestNorm = matrix.NormInf
+	testCases[3].TestTol = 10
+	testCases[3].MaxIter = 500
+	testCases[3].ExpectedValue = []float64{80.0 / 3, 100.0 / 3, 650.0 / 27}
+	testCases[3].ExpectedIter = 5
+
+	testCases[4].TestFunctions = nonlinearFunc
+	testCases[4].TestCaseName = "nonlinear system"
+	testCases[4].InitialEst = []float64{0, 0}
+	testCases[4].TestNorm = matrix.NormTwo
+	testCases[4].TestTol = 12
+	testCases[4].MaxIter = 50
+	testCases[4].ExpectedValue = []float64{0.403733312344638, 0.630951409640190}
+	testCases[4].ExpectedIter = 24
+
+	testCases[5].TestFunctions = nonlinearFunc
+	testCases[5].TestCaseName = "nonlinear system anderson"
+	testCases[5].InitialEst = []float64{0, 0}
+	testCases[5].TestDepth = 2
+	testCases[5].TestNorm = matrix.NormTwo
+	testCases[5].TestTol = 12
+	testCases[5].MaxIter = 50
+	testCases[5].ExpectedValue = []float64{0.403733312344638, 0.630951409640190}
+	testCases[5].ExpectedIter = 8
+
+	testCases[6].TestFunctions = nonlinearFunc
+	testCases[6].TestCaseName = "nonlinear system anderson one norm"
+	testCases[6].InitialEst = []float64{0, 0}
+	testCases[6].TestDepth = 2
+	testCases[6].TestNorm = matrix.NormOne
+	testCases[6].TestTol = 12
+	testCases[6].MaxIter = 50
+	testCases[6].ExpectedValue = []float64{0.403733312344638, 0.630951409640190}
+	testCases[6].ExpectedIter = 8
+
+	// Test case: algorythm prformance and results
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		iter, pAprox, errAprox, relErr, pSeries, err := FixPtVec(tc.TestFunctions, tc.InitialEst, tc.TestDepth, tc.TestNorm, tc.TestTol, tc.MaxIter)
+		decTol := 1.0 / math.Pow10(tc.TestTol)
+		if err != nil {
+			t.Errorf("unexpected error for case %s. %v", tc.TestCaseName, err)
+			continue
+		}
+		for k := range tc.ExpectedValue {
+			if math.Abs(tc.ExpectedValue[k]-pAprox[k]) >= 1e-6*math.Max(1, math.Abs(tc.ExpectedValue[k])) {
+				t.Errorf("wrong values for case %s. expected aprox: %v, received: %v", tc.TestCaseName, tc.ExpectedValue, pAprox)
+				break
+			}
+		}
+		if iter != tc.ExpectedIter {
+			t.Errorf("wrong values for case %s. expected iter: %d, received: %d", tc.TestCaseName, tc.ExpectedIter, iter)
+		}
+		if (errAprox >= decTol) && (relErr >= decTol) {
+			t.Errorf("wrong values for case %s. expected errors below: %e, received: %e, %e", tc.TestCaseName, decTol, errAprox, relErr)
+		}
+		if errAprox != matrix.VectNorm(vectSub(pSeries[iter], pSeries[iter-1]), tc.TestNorm) {
+			t.Errorf("wrong absolute error norm for case %s", tc.TestCaseName)
+		}
+		if len(pSeries) != iter+1 {
+			t.Errorf("wrong series length for case %s. expected: %d, received: %d", tc.TestCaseName, iter+1, len(pSeries))
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+		t.Logf("testing error signals case number: %s", tc.TestCaseName)
+		//test case: error maximum iterations reached
+		_, _, _, _, _, err = FixPtVec(tc.TestFunctions, tc.InitialEst, tc.TestDepth, tc.TestNorm, tc.TestTol, 3)
+		switch err {
+		case nil:
+			t.Error("maximum iteration reached error not catched") // Fail to catch error. Algorithm should not converge
+		}
+		t.Logf("testing error signals case number: %s OK", tc.TestCaseName)
+	}
+}
+
+type testStructSystemFinder struct {
+	FinderName string
+	Finder     SystemFinder
+	Fixed      bool // the finder solves X = G(X)
+}
+
+func TestSystemFinderObserver(t *testing.T) {
+	// ex. 3.43, also written as the fixed point problem X = G(X)
+	f := func(x []float64) []float64 {
+		return []float64{
+			x[0]*x[0] - 2*x[0] - x[1] + 0.5,
+			x[0]*x[0] + 4*x[1]*x[1] - 4,
+		}
+	}
+	g := func(x []float64) []float64 {
+		return []float64{
+			(x[0]*x[0] - x[1] + 0.5) / 2,
+			(-x[0]*x[0] - 4*x[1]*x[1] + 8*x[1] + 4) / 8,
+		}
+	}
+	testCases := make([]testStructSystemFinder, 4)
+	testCases[0].FinderName = "NewtonSystem"
+	testCases[0].Finder = NewtonSystemFinder{P0: []float64{2, 0.25}}
+	testCases[1].FinderName = "Broyden"
+	testCases[1].Finder = BroydenFinder{P0: []float64{2, 0.25}, Method: BroydenGood}
+	testCases[2].FinderName = "Broyden bad"
+	testCases[2].Finder = BroydenFinder{P0: []float64{2, 0.25}, Method: BroydenBad}
+	testCases[3].FinderName = "FixPtVec"
+	testCases[3].Finder = FixPtVecFinder{P0: []float64{0, 1}, Depth: 2, Norm: matrix.NormTwo}
+	testCases[3].Fixed = true
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.FinderName)
+		fn := f
+		if tc.Fixed {
+			fn = g
+		}
+		// Test case: the recorder collects every iteration
+		rec := &VecRecorder{}
+		opts := DefaultOptions()
+		opts.VecObserver = rec.Observe
+		res, err := tc.Finder.FindRoot(fn, opts)
+		if err != nil {
+			t.Errorf("unexpected error for case: %s. %v", tc.FinderName, err)
+			continue
+		}
+		if len(rec.Trace) != res.Iter || res.Evals == 0 {
+			t.Errorf("wrong trace length for case: %s. expecting: %d, receiving %d", tc.FinderName, res.Iter, len(rec.Trace))
+			continue
+		}
+		for k, it := range rec.Trace {
+			if it.Iter != k+1 {
+				t.Errorf("wrong iteration number for case: %s. expecting: %d, receiving %d", tc.FinderName, k+1, it.Iter)
+			}
+		}
+		if conv := rec.Convergence(); tc.FinderName == "NewtonSystem" && (conv.Behavior != Converging || math.Abs(conv.Order-2) > 0.3) {
+			t.Errorf("wrong convergence for case: %s. expecting: order 2, receiving %f (%s)", tc.FinderName, conv.Order, conv)
+		}
+		last := rec.Trace[len(rec.Trace)-1]
+		if matrix.VectNorm(vectSub(last.X, res.Root), matrix.NormTwo) != 0 || last.AbsErr != res.AbsErr {
+			t.Errorf("wrong last iteration for case: %s. expecting: %v, receiving %v", tc.FinderName, res.Root, last.X)
+		}
+		t.Logf("testing case number: %s OK", tc.FinderName)
+
+		t.Logf("testing error signals case number: %s", tc.FinderName)
+		// Test case: the observer stops the iteration early
+		opts.VecObserver = func(it VecIteration) bool {
+			return it.Iter == 2
+		}
+		res, err = tc.Finder.FindRoot(fn, opts)
+		if err != ErrStopped {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.FinderName, ErrStopped, err)
+		}
+		if res.Iter != 2 || len(res.Root) != 2 {
+			t.Errorf("wrong result for case: %s. expecting the second iteration, receiving %d (%v)", tc.FinderName, res.Iter, res.Root)
+		}
+		t.Logf("testing error signals case number: %s OK", tc.FinderName)
+	}
+}
+
+func TestSystemsCtx(t *testing.T) {
+	// ex. 3.43, also written as the fixed point problem X = G(X)
+	f := func(x []float64) []float64 {
+		return []float64{
+			x[0]*x[0] - 2*x[0] - x[1] + 0.5,
+			x[0]*x[0] + 4*x[1]*x[1] - 4,
+		}
+	}
+	jac := func(x []float64) [][]float64 {
+		return [][]float64{
+			{2*x[0] - 2, -1},
+			{2 * x[0], 8 * x[1]},
+		}
+	}
+	g := func(x []float64) []float64 {
+		return []float64{
+			(x[0]*x[0] - x[1] + 0.5) / 2,
+			(-x[0]*x[0] - 4*x[1]*x[1] + 8*x[1] + 4) / 8,
+		}
+	}
+
+	t.Logf("testing cancelled context")
+	ctx, cancel := context.WithCancel(context.Background())
+	evals := 0
+	// the function cancels the context on its third evaluation, so the solvers must stop right after it
+	fCancel := func(x []float64) []float64 {
+		evals++
+		if evals == 3 {
+			cancel()
+		}
+		return f(x)
+	}
+	_, _, _, _, err := NewtonSystemCtx(ctx, fCancel, jac, []float64{2, 0.25}, 1e-12, 1e-12, 20, 0)
+	if !errors.Is(err, context.Canceled) || evals != 3 {
+		t.Errorf("wrong error for the newton method. expecting: %v after 3 evaluations, receiving %v after %d", context.Canceled, err, evals)
+	}
+	// the context is already done
+	_, _, _, _, _, err = BroydenCtx(ctx, f, []float64{2, 0.25}, BroydenGood, false, 1e-12, 1e-12, 20, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the broyden method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	_, _, _, _, _, err = FixPtVecCtx(ctx, g, []float64{0, 1}, 2, matrix.NormTwo, 10, 50, 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the fixed point method. expecting: %v, receiving %v", context.Canceled, err)
+	}
+	_, err = FindSystemRootCtx(ctx, NewtonSystemFinder{P0: []float64{2, 0.25}}, f, DefaultOptions())
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("wrong error for the newton finder. expecting: %v, receiving %v", context.Canceled, err)
+	}
+
+	t.Logf("testing evaluation budget")
+	// the newton method needs 2i+3 evaluations of f and its Jacobian to converge at (zero based) iteration i
+	_, _, _, i, err := NewtonSystemCtx(context.Background(), f, jac, []float64{2, 0.25}, 1e-12, 1e-12, 20, 0)
+	if err != nil {
+		t.Errorf("unexpected error for the newton method. %v", err)
+	}
+	_, _, _, _, err = NewtonSystemCtx(context.Background(), f, jac, []float64{2, 0.25}, 1e-12, 1e-12, 20, 2*i+2)
+	if err != ErrMaxEval {
+		t.Errorf("wrong error for the newton method. expecting: %v, receiving %v", ErrMaxEval, err)
+	}
+	_, _, _, _, err = NewtonSystemCtx(context.Background(), f, jac, []float64{2, 0.25}, 1e-12, 1e-12, 20, 2*i+3)
+	if err != nil {
+		t.Errorf("unexpected error for the newton method. %v", err)
+	}
+	// every column of the finite difference Jacobian is one evaluation of f
+	res, err := NewtonSystemFinder{P0: []float64{2, 0.25}}.FindRoot(f, DefaultOptions())
+	if err != nil || res.Evals != 3*res.Iter+1 {
+		t.Errorf("wrong evaluations for the newton finder. expecting: %d, receiving %d (%v)", 3*res.Iter+1, res.Evals, err)
+	}
+	evals = 0
+	fCount := func(x []float64) []float64 {
+		evals++
+		return f(x)
+	}
+	_, _, _, _, _, err = BroydenCtx(context.Background(), fCount, []float64{2, 0.25}, BroydenBad, false, 1e-12, 1e-12, 20, 4)
+	if err != ErrMaxEval || evals != 4 {
+		t.Errorf("wrong budget for the broyden method. expecting: %v after 4 evaluations, receiving %v after %d", ErrMaxEval, err, evals)
+	}
+	_, _, _, _, _, err = FixPtVecCtx(context.Background(), g, []float64{0, 1}, 2, matrix.NormTwo, 10, 50, 3)
+	if err != ErrMaxEval {
+		t.Errorf("wrong error for the fixed point method. expecting: %v, receiving %v", ErrMaxEval, err)
+	}
+}
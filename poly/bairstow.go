@@ -0,0 +1,119 @@
+package poly
+
+import (
+	"math"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+// Bairstow factors a polynomial with real coefficients into real quadratic factors x^2 + r x + s with the Bairstow's
+// Method, so no complex arithmetic is needed. Each factor is found with Newton-Raphson iterations on (r, s) that zero the
+// remainder of the division of p by x^2 + r x + s, and then it is removed from the polynomial (deflation). The last factor
+// is linear when the degree of p is odd
+// Every factor uses the same criteria of NewtonRaphson: it stops when the step |(dr, ds)| is lower than delta, when the
+// relative step is lower than delta or when the remainder is lower than epsilon
+// The convergence is quadratic near a factor, but far from it (r, s) can wander for many iterations, so r0 and s0 should
+// be close to the coefficients of a factor when they are known (e.g. from a previous factorization)
+// Inputs:
+//
+//	p is the polynomial
+//	r0, s0 are the initial values of r and s of every factor
+//	delta is the tolerance for r and s
+//	epsilon is the tolerance for the remainder
+//	maxIter is the maximum iteration of the algorithm for each factor
+//
+// Outputs:
+//
+//	factors are the monic factors in the order they were found, p = p[n] * factors[0] * factors[1] * ...
+//	roots are the roots of the factors, sorted by real part with the complex conjugate roots in consecutive pairs
+//	i is the largest iteration that generated a factor
+//
+// ErrZeroDerivative is returned if the Jacobian of the remainder is singular before reaching a factor
+func Bairstow[R matrix.Real](p Polynomial[R], r0, s0, delta, epsilon float64, maxIter int) (factors []Polynomial[float64], roots []complex128, i int, err error) {
+	p = p.trim()
+	if len(p) == 0 {
+		return nil, nil, 0, ErrZeroPolynomial
+	}
+	n := len(p) - 1
+	a := make([]float64, len(p))
+	for k := range p {
+		a[k] = float64(p[k]) / float64(p[n])
+	}
+	roots = make([]complex128, 0, n)
+	for n > 2 {
+		r, s, q, iter, err := quadraticFactor(a, r0, s0, delta, epsilon, maxIter)
+		i = max(i, iter)
+		if err != nil {
+			return nil, nil, i, err
+		}
+		factors = append(factors, Polynomial[float64]{s, r, 1})
+		roots = append(roots, quadraticRoots(r, s)...)
+		a = q
+		n -= 2
+	}
+	switch n {
+	case 2:
+		factors = append(factors, Polynomial[float64]{a[0], a[1], 1})
+		roots = append(roots, quadraticRoots(a[1], a[0])...)
+	case 1:
+		factors = append(factors, Polynomial[float64]{a[0], 1})
+		roots = append(roots, complex(-a[0], 0))
+	}
+	pairConjugates(roots)
+	return factors, roots, i, nil
+}
+
+// quadraticFactor returns the factor x^2 + r x + s of the monic polynomial a (degree 3 or higher) and the quotient q
+// The division a(x) = (x^2 + r x + s) q(x) + b1 (x + r) + b0 and its derivatives with respect to r and s are computed with
+// the synthetic division, and (r, s) is updated with the Newton-Raphson step that zeroes (b1, b0)
+func quadraticFactor(a []float64, r, s, delta, epsilon float64, maxIter int) (r1, s1 float64, q []float64, i int, err error) {
+	n := len(a) - 1
+	b := make([]float64, n+1)
+	c := make([]float64, n+1)
+	divide := func() {
+		b[n] = a[n]
+		b[n-1] = a[n-1] - r*b[n]
+		c[n] = b[n]
+		c[n-1] = b[n-1] - r*c[n]
+		for k := n - 2; k >= 0; k-- {
+			b[k] = a[k] - r*b[k+1] - s*b[k+2]
+			c[k] = b[k] - r*c[k+1] - s*c[k+2]
+		}
+	}
+	divide()
+	for i = 0; i < maxIter; i++ {
+		det := c[2]*c[2] - c[1]*c[3]
+		if det == 0 {
+			if b[0] == 0 && b[1] == 0 {
+				return r, s, append([]float64(nil), b[2:]...), i, nil
+			}
+			return math.NaN(), math.NaN(), nil, i, nonlineareq.ErrZeroDerivative
+		}
+		dr := (b[1]*c[2] - b[0]*c[3]) / det
+		ds := (b[0]*c[2] - b[1]*c[1]) / det
+		r += dr
+		s += ds
+		divide()
+		absErr := math.Hypot(dr, ds)
+		relErr := 2 * absErr / (math.Hypot(r, s) + delta)
+		if (absErr < delta) || (relErr < delta) || (math.Hypot(b[0], b[1]) < epsilon) {
+			return r, s, append([]float64(nil), b[2:]...), i, nil
+		}
+	}
+	return math.NaN(), math.NaN(), nil, i, nonlineareq.ErrMaxIter
+}
+
+// quadraticRoots returns the roots of x^2 + r x + s, the real roots are computed without cancellation
+func quadraticRoots(r, s float64) []complex128 {
+	disc := r*r - 4*s
+	if disc < 0 {
+		im := math.Sqrt(-disc) / 2
+		return []complex128{complex(-r/2, -im), complex(-r/2, im)}
+	}
+	q := -(r + math.Copysign(math.Sqrt(disc), r)) / 2
+	if q == 0 {
+		return []complex128{0, 0}
+	}
+	return []complex128{complex(q, 0), complex(s/q, 0)}
+}
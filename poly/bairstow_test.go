@@ -0,0 +1,100 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+type testStructBairstow struct {
+	TestCaseName  string
+	TestPoly      Polynomial[float64]
+	Expected      []complex128
+	Tol           float64
+	ExpectedError error
+}
+
+func TestBairstow(t *testing.T) {
+	testCases := []testStructBairstow{
+		{
+			TestCaseName: "ex. 2.2",
+			TestPoly:     New[float64](-10, 0, 4, 1),
+			Expected:     []complex128{complex(-2.6826150067070485, -0.3582593599240428), complex(-2.6826150067070485, 0.3582593599240428), 1.365230013414097},
+			Tol:          1e-12,
+		},
+		{
+			// 2(x^2 + 2x + 5)(x^2 + 1)(x - 1)
+			TestCaseName: "conjugate pairs",
+			TestPoly:     New[float64](-10, 6, -8, 8, 2, 2),
+			Expected:     []complex128{-1 - 2i, -1 + 2i, -1i, 1i, 1},
+			Tol:          1e-12,
+		},
+		{
+			// (x - 1)(x - 2)...(x - 6)
+			TestCaseName: "integer roots",
+			TestPoly:     New[float64](720, -1764, 1624, -735, 175, -21, 1),
+			Expected:     []complex128{1, 2, 3, 4, 5, 6},
+			Tol:          1e-10,
+		},
+		{
+			TestCaseName: "constant",
+			TestPoly:     New[float64](5),
+			Expected:     []complex128{},
+		},
+		{
+			TestCaseName:  "zero polynomial",
+			TestPoly:      New[float64](0),
+			ExpectedError: ErrZeroPolynomial,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		factors, roots, _, err := Bairstow(tc.TestPoly, 1, 1, 1e-14, 0, 100)
+		if err != tc.ExpectedError {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedError, err)
+			continue
+		}
+		if err != nil {
+			t.Logf("testing case number: %s OK", tc.TestCaseName)
+			continue
+		}
+		if len(roots) != len(tc.Expected) {
+			t.Errorf("wrong number of roots for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, roots)
+			continue
+		}
+		for k := range roots {
+			if cmplx.Abs(roots[k]-tc.Expected[k]) > tc.Tol {
+				t.Errorf("wrong roots for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, roots)
+				break
+			}
+		}
+		// Test case: the factors multiply back to the polynomial
+		prod := New[float64](tc.TestPoly[len(tc.TestPoly)-1])
+		for _, f := range factors {
+			if f.Degree() > 2 || f[len(f)-1] != 1 {
+				t.Errorf("wrong factor for case: %s. receiving %v", tc.TestCaseName, f)
+			}
+			prod = prod.Mul(f)
+		}
+		for k := range tc.TestPoly {
+			if k >= len(prod) || math.Abs(prod[k]-tc.TestPoly[k]) > tc.Tol*math.Max(math.Abs(tc.TestPoly[k]), 1)*100 {
+				t.Errorf("wrong factorization for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.TestPoly, prod)
+				break
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing error signals")
+	if _, _, _, err := Bairstow(testCases[2].TestPoly, 1, 1, 1e-14, 0, 2); err != nonlineareq.ErrMaxIter {
+		t.Errorf("wrong error. expecting: %v, receiving %v", nonlineareq.ErrMaxIter, err)
+	}
+	// the remainder tolerance stops the iteration early
+	_, roots, _, err := Bairstow(New[float64](2, 0, -3, 0, 1), 0.1, -1.5, 0, 1e-3, 100)
+	if err != nil || math.Abs(cmplx.Abs(roots[0])-math.Sqrt2) > 1e-2 {
+		t.Errorf("wrong estimation. expecting: +-%f, receiving %v (%v)", math.Sqrt2, roots, err)
+	}
+}
@@ -0,0 +1,179 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+// laguerreTries is the number of times a Laguerre step is shortened and rotated while it does not reduce |p(x)|
+const laguerreTries = 8
+
+// laguerreTurn shortens the step to one half and rotates it by arctan(4/3), so the shortened steps sweep around x
+const laguerreTurn = complex(0.3, 0.4)
+
+// Laguerre estimates every (complex) root of a polynomial with real coefficients with the Laguerre's Method
+// The roots are found one at a time starting from 0 (Laguerre's method converges from almost any point), and every root
+// is removed from the polynomial with the synthetic division (deflation). Then each root is polished with the Laguerre's
+// method on the original polynomial, to remove the rounding errors accumulated by the deflation
+// Inputs:
+//
+//	p is the polynomial
+//	delta is the tolerance for the roots
+//	epsilon is the tolerance for |p(z)|
+//	maxIter is the maximum iteration of the algorithm for each root
+//
+// Outputs:
+//
+//	roots are the roots of p, sorted by real part with the complex conjugate roots in consecutive pairs (the root with the
+//	negative imaginary part first)
+func Laguerre[R matrix.Real](p Polynomial[R], delta, epsilon float64, maxIter int) (roots []complex128, err error) {
+	p = p.trim()
+	if len(p) == 0 {
+		return nil, ErrZeroPolynomial
+	}
+	coeffs := ToComplex(p)
+	n := len(coeffs) - 1
+	roots = make([]complex128, 0, n)
+	deflated := append(Polynomial[complex128](nil), coeffs...)
+	for len(deflated) > 1 {
+		z, err := laguerreRoot(deflated, 0, delta, epsilon, maxIter)
+		if err != nil {
+			return nil, err
+		}
+		z = realIfRounding(z)
+		roots = append(roots, z)
+		deflated = deflate(deflated, z)
+	}
+	for k := range roots {
+		z, err := laguerreRoot(coeffs, roots[k], delta, epsilon, maxIter)
+		if err != nil {
+			return nil, err
+		}
+		roots[k] = realIfRounding(z)
+	}
+	pairConjugates(roots)
+	return roots, nil
+}
+
+// laguerreRoot returns a root of the polynomial with the Laguerre's Method starting from x
+// The step is the Laguerre's correction n / (G +- sqrt((n - 1)(nH - G^2))), with G = p1/p, H = G^2 - p2/p and p1, p2 the
+// first and second derivatives, taking the sign that gives the largest denominator (J. H. Wilkinson, The Algebraic
+// Eigenvalue Problem, 1965). Laguerre's method is not a descent method, so a step that does not reduce |p(x)| is shortened
+// and rotated until it does, following the safeguard of the Newton's method of K. Madsen (A root-finding algorithm based
+// on Newton's method, BIT 13, 1973), which also breaks the rare limit cycles. The iteration stops when |p(x)| is below the
+// rounding error of its evaluation
+func laguerreRoot(coeffs Polynomial[complex128], x complex128, delta, epsilon float64, maxIter int) (complex128, error) {
+	n := len(coeffs) - 1
+	m := complex(float64(n), 0)
+	px, dpx, d2px, roundErr := hornerBound(coeffs, x)
+	for i := 1; i <= maxIter; i++ {
+		if cmplx.Abs(px) <= roundErr || cmplx.Abs(px) < epsilon {
+			return x, nil
+		}
+		g := dpx / px
+		h := g*g - d2px/px
+		sq := cmplx.Sqrt((m - 1) * (m*h - g*g))
+		den := g + sq
+		if cmplx.Abs(g-sq) > cmplx.Abs(den) {
+			den = g - sq
+		}
+		var dx complex128
+		if den != 0 {
+			dx = m / den
+		} else {
+			// p'(x) and p''(x) vanish, the step is the radius of a disk that contains every root
+			dx = complex(rootBound(coeffs), 0)
+		}
+		x1 := x - dx
+		p1, dp1, d2p1, err1 := hornerBound(coeffs, x1)
+		for try := 0; try < laguerreTries && cmplx.Abs(p1) >= cmplx.Abs(px) && cmplx.Abs(p1) > err1; try++ {
+			dx *= laguerreTurn
+			x1 = x - dx
+			p1, dp1, d2p1, err1 = hornerBound(coeffs, x1)
+		}
+		absErr := cmplx.Abs(dx)
+		if x1 == x || absErr < delta || 2*absErr/(cmplx.Abs(x1)+delta) < delta {
+			return x1, nil
+		}
+		x, px, dpx, d2px, roundErr = x1, p1, dp1, d2p1, err1
+	}
+	return cmplx.NaN(), nonlineareq.ErrMaxIter
+}
+
+// hornerBound evaluates p(x) and its first and second derivatives with the Horner's method, together with the running bound of the rounding
+// error of p(x) (N. J. Higham, Accuracy and Stability of Numerical Algorithms, 2nd ed., Algorithm 5.1). The bound is
+// doubled because the complex products have about twice the rounding error of the real ones
+func hornerBound(coeffs Polynomial[complex128], x complex128) (px, dpx, d2px complex128, roundErr float64) {
+	n := len(coeffs) - 1
+	absX := cmplx.Abs(x)
+	px = coeffs[n]
+	mu := cmplx.Abs(px) / 2
+	for j := n - 1; j >= 0; j-- {
+		d2px = x*d2px + 2*dpx
+		dpx = x*dpx + px
+		px = x*px + coeffs[j]
+		mu = absX*mu + cmplx.Abs(px)
+	}
+	unit := (math.Nextafter(1, 2) - 1) / 2
+	return px, dpx, d2px, 2 * unit * (2*mu - cmplx.Abs(px))
+}
+
+// deflate returns the quotient of the polynomial divided by (x - z) with the synthetic division
+func deflate(coeffs Polynomial[complex128], z complex128) Polynomial[complex128] {
+	n := len(coeffs) - 1
+	quot := make(Polynomial[complex128], n)
+	b := coeffs[n]
+	for j := n - 1; j >= 0; j-- {
+		quot[j] = b
+		b = coeffs[j] + b*z
+	}
+	return quot
+}
+
+// realIfRounding returns z as a real number if its imaginary part is at the level of the rounding error
+func realIfRounding(z complex128) complex128 {
+	if math.Abs(imag(z)) <= 2*(math.Nextafter(1, 2)-1)*math.Abs(real(z)) {
+		return complex(real(z), 0)
+	}
+	return z
+}
+
+// pairConjugates matches every complex root of a real polynomial with its conjugate, makes them exact conjugates and sorts
+// the roots by real part with the conjugate pairs together
+func pairConjugates(roots []complex128) {
+	paired := make([]bool, len(roots))
+	for i := range roots {
+		if paired[i] || imag(roots[i]) == 0 {
+			continue
+		}
+		partner := -1
+		for j := range roots {
+			if j != i && !paired[j] && imag(roots[j]) != 0 &&
+				(partner < 0 || cmplx.Abs(roots[j]-cmplx.Conj(roots[i])) < cmplx.Abs(roots[partner]-cmplx.Conj(roots[i]))) {
+				partner = j
+			}
+		}
+		// a root without a close conjugate (a real root with rounding errors) is not paired
+		if partner < 0 || cmplx.Abs(roots[partner]-cmplx.Conj(roots[i])) >= math.Abs(imag(roots[i])) {
+			continue
+		}
+		z := (roots[i] + cmplx.Conj(roots[partner])) / 2
+		roots[i] = z
+		roots[partner] = cmplx.Conj(z)
+		paired[i] = true
+		paired[partner] = true
+	}
+	sort.SliceStable(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		if math.Abs(imag(roots[i])) != math.Abs(imag(roots[j])) {
+			return math.Abs(imag(roots[i])) < math.Abs(imag(roots[j]))
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+}
@@ -0,0 +1,102 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+type testStructLaguerre struct {
+	TestCaseName  string
+	TestPoly      Polynomial[float64]
+	Expected      []complex128
+	Tol           float64
+	ExpectedError error
+}
+
+func TestLaguerre(t *testing.T) {
+	testCases := []testStructLaguerre{
+		{
+			TestCaseName: "ex. 2.2",
+			TestPoly:     New[float64](-10, 0, 4, 1),
+			Expected:     []complex128{complex(-2.6826150067070485, -0.3582593599240428), complex(-2.6826150067070485, 0.3582593599240428), 1.365230013414097},
+			Tol:          1e-12,
+		},
+		{
+			// (x - 1)(x - 2)(x^2 + 1) x^2
+			TestCaseName: "roots at 0",
+			TestPoly:     New[float64](0, 0, 2, -3, 3, -3, 1),
+			Expected:     []complex128{0, 0, -1i, 1i, 1, 2},
+			Tol:          1e-12,
+		},
+		{
+			// (x^2 + 2x + 5)(x^2 + 1)(x - 1)
+			TestCaseName: "conjugate pairs",
+			TestPoly:     New[float64](-5, 3, -4, 4, 1, 1),
+			Expected:     []complex128{-1 - 2i, -1 + 2i, -1i, 1i, 1},
+			Tol:          1e-12,
+		},
+		{
+			// (x - 1)(x - 2)...(x - 8)
+			TestCaseName: "integer roots",
+			TestPoly:     New[float64](40320, -109584, 118124, -67284, 22449, -4536, 546, -36, 1),
+			Expected:     []complex128{1, 2, 3, 4, 5, 6, 7, 8},
+			Tol:          1e-10,
+		},
+		{
+			// p'(0) = p''(0) = 0, so the first step can not be computed from the starting point
+			TestCaseName: "x^4 + 1",
+			TestPoly:     New[float64](1, 0, 0, 0, 1),
+			Expected:     []complex128{complex(-math.Sqrt2/2, -math.Sqrt2/2), complex(-math.Sqrt2/2, math.Sqrt2/2), complex(math.Sqrt2/2, -math.Sqrt2/2), complex(math.Sqrt2/2, math.Sqrt2/2)},
+			Tol:          1e-12,
+		},
+		{
+			// (x - 1)^2 (x + 2), the double root is only resolved to ~sqrt(eps)
+			TestCaseName: "double root",
+			TestPoly:     New[float64](2, -3, 0, 1),
+			Expected:     []complex128{-2, 1, 1},
+			Tol:          1e-7,
+		},
+		{
+			TestCaseName: "constant",
+			TestPoly:     New[float64](5),
+			Expected:     []complex128{},
+		},
+		{
+			TestCaseName:  "zero polynomial",
+			TestPoly:      New[float64](0),
+			ExpectedError: ErrZeroPolynomial,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		roots, err := Laguerre(tc.TestPoly, 1e-15, 0, 100)
+		if err != tc.ExpectedError {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedError, err)
+			continue
+		}
+		if len(roots) != len(tc.Expected) {
+			t.Errorf("wrong number of roots for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, roots)
+			continue
+		}
+		for k := range roots {
+			if cmplx.Abs(roots[k]-tc.Expected[k]) > tc.Tol {
+				t.Errorf("wrong roots for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, roots)
+				break
+			}
+			// Test case: the complex roots are exact conjugate pairs
+			if imag(roots[k]) < 0 && roots[k+1] != cmplx.Conj(roots[k]) {
+				t.Errorf("wrong conjugate pair for case: %s. receiving %v and %v", tc.TestCaseName, roots[k], roots[k+1])
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing error signals")
+	if _, err := Laguerre(testCases[3].TestPoly, 1e-15, 0, 1); err != nonlineareq.ErrMaxIter {
+		t.Errorf("wrong error. expecting: %v, receiving %v", nonlineareq.ErrMaxIter, err)
+	}
+}
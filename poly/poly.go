@@ -0,0 +1,255 @@
+// Package poly implements polynomials with real or complex coefficients
+package poly
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+	"golang.org/x/exp/constraints"
+)
+
+var ErrDivByZero = errors.New("division by the zero polynomial")
+
+// Polynomial holds the coefficients of a polynomial in ascending order, p[k] is the coefficient of x^k
+// The zero polynomial has no coefficients
+type Polynomial[Num matrix.Number] []Num
+
+// New returns the polynomial with the coefficients in ascending order, without the trailing zero coefficients
+func New[Num matrix.Number](coeffs ...Num) Polynomial[Num] {
+	return Polynomial[Num](append([]Num(nil), coeffs...)).trim()
+}
+
+// Degree returns the degree of the polynomial, -1 for the zero polynomial
+func (p Polynomial[Num]) Degree() int {
+	return len(p.trim()) - 1
+}
+
+// Eval evaluates the polynomial at x with the Horner's method
+func (p Polynomial[Num]) Eval(x Num) (y Num) {
+	for k := len(p) - 1; k >= 0; k-- {
+		y = y*x + p[k]
+	}
+	return y
+}
+
+// EvalComplex evaluates the polynomial with real coefficients at the complex number z with the Horner's method
+// The polynomials with complex coefficients are evaluated with Eval
+func EvalComplex[R matrix.Real](p Polynomial[R], z complex128) (y complex128) {
+	for k := len(p) - 1; k >= 0; k-- {
+		y = y*z + complex(float64(p[k]), 0)
+	}
+	return y
+}
+
+// Add returns the sum p + q
+func (p Polynomial[Num]) Add(q Polynomial[Num]) Polynomial[Num] {
+	sum := make(Polynomial[Num], max(len(p), len(q)))
+	copy(sum, p)
+	for k := range q {
+		sum[k] += q[k]
+	}
+	return sum.trim()
+}
+
+// Sub returns the difference p - q
+func (p Polynomial[Num]) Sub(q Polynomial[Num]) Polynomial[Num] {
+	diff := make(Polynomial[Num], max(len(p), len(q)))
+	copy(diff, p)
+	for k := range q {
+		diff[k] -= q[k]
+	}
+	return diff.trim()
+}
+
+// Scale returns the product c * p
+func (p Polynomial[Num]) Scale(c Num) Polynomial[Num] {
+	prod := make(Polynomial[Num], len(p))
+	for k := range p {
+		prod[k] = c * p[k]
+	}
+	return prod.trim()
+}
+
+// Mul returns the product p * q
+func (p Polynomial[Num]) Mul(q Polynomial[Num]) Polynomial[Num] {
+	if len(p) == 0 || len(q) == 0 {
+		return nil
+	}
+	prod := make(Polynomial[Num], len(p)+len(q)-1)
+	for i := range p {
+		for j := range q {
+			prod[i+j] += p[i] * q[j]
+		}
+	}
+	return prod.trim()
+}
+
+// Div divides p by q with the long division, p = quot*q + rem where the degree of rem is lower than the degree of q
+// The coefficients of integer polynomials are divided with the integer division, so p = quot*q + rem still holds but the
+// degree of rem is lower than the degree of q only if the leading coefficient of q divides the coefficients of p
+// Input:
+// q is the divisor
+// Output:
+// quot is the quotient
+// rem is the remainder
+func (p Polynomial[Num]) Div(q Polynomial[Num]) (quot, rem Polynomial[Num], err error) {
+	q = q.trim()
+	if len(q) == 0 {
+		return nil, nil, ErrDivByZero
+	}
+	rem = append(Polynomial[Num](nil), p.trim()...)
+	if len(rem) < len(q) {
+		return nil, rem, nil
+	}
+	quot = make(Polynomial[Num], len(rem)-len(q)+1)
+	lead := q[len(q)-1]
+	exact := fractional[Num]()
+	for k := len(quot) - 1; k >= 0; k-- {
+		c := rem[k+len(q)-1] / lead
+		quot[k] = c
+		for j := range q {
+			rem[k+j] -= c * q[j]
+		}
+		if exact {
+			// the leading coefficient is removed exactly, also when the rounding leaves a residue
+			rem[k+len(q)-1] = 0
+		}
+	}
+	return quot.trim(), rem.trim(), nil
+}
+
+// fractional reports whether Num is a float or complex type, where the division has no remainder
+func fractional[Num matrix.Number]() bool {
+	var one Num = 1
+	return one/2 != 0
+}
+
+// Derivative returns the derivative of the polynomial
+func (p Polynomial[Num]) Derivative() Polynomial[Num] {
+	if len(p) < 2 {
+		return nil
+	}
+	d := make(Polynomial[Num], len(p)-1)
+	// the factor is accumulated, as integers can not be converted to complex types
+	var factor Num
+	for k := 1; k < len(p); k++ {
+		factor++
+		d[k-1] = factor * p[k]
+	}
+	return d.trim()
+}
+
+// Antiderivative returns the antiderivative of the polynomial with the integration constant c
+// The coefficients of integer polynomials are divided with the integer division
+func (p Polynomial[Num]) Antiderivative(c Num) Polynomial[Num] {
+	a := make(Polynomial[Num], len(p)+1)
+	a[0] = c
+	var factor Num
+	for k := range p {
+		factor++
+		a[k+1] = p[k] / factor
+	}
+	return a.trim()
+}
+
+// Compose returns the composition p(q(x)), evaluated with the Horner's method
+func (p Polynomial[Num]) Compose(q Polynomial[Num]) (comp Polynomial[Num]) {
+	for k := len(p) - 1; k >= 0; k-- {
+		comp = comp.Mul(q).Add(Polynomial[Num]{p[k]})
+	}
+	return comp
+}
+
+// trim removes the trailing zero coefficients
+func (p Polynomial[Num]) trim() Polynomial[Num] {
+	n := len(p)
+	for n > 0 && p[n-1] == 0 {
+		n--
+	}
+	if n == 0 {
+		return nil
+	}
+	return p[:n]
+}
+
+// GCD returns the monic greatest common divisor of the polynomials with the Euclidean algorithm
+// The coefficients of a remainder below tol times the largest coefficient of the divisor are taken as 0, so the common
+// roots of polynomials with rounding errors are also found
+// Input:
+// a, b are the polynomials
+// tol is the relative tolerance of the remainders
+// Output:
+// gcd is the monic greatest common divisor (the zero polynomial if both polynomials are zero)
+func GCD[F constraints.Float](a, b Polynomial[F], tol float64) (gcd Polynomial[F], err error) {
+	return euclidGCD(a, b, tol, func(c F) float64 { return math.Abs(float64(c)) })
+}
+
+// GCDComplex is the version of GCD for the polynomials with complex coefficients
+func GCDComplex[C constraints.Complex](a, b Polynomial[C], tol float64) (gcd Polynomial[C], err error) {
+	return euclidGCD(a, b, tol, func(c C) float64 { return cmplx.Abs(complex128(c)) })
+}
+
+// euclidGCD runs the Euclidean algorithm of GCD, abs returns the modulus of a coefficient
+func euclidGCD[F constraints.Float | constraints.Complex](a, b Polynomial[F], tol float64, abs func(c F) float64) (Polynomial[F], error) {
+	a = a.trim()
+	b = b.trim()
+	for len(b) > 0 {
+		_, rem, err := a.Div(b)
+		if err != nil {
+			return nil, err
+		}
+		scale := 0.0
+		for _, c := range b {
+			scale = max(scale, abs(c))
+		}
+		for k, c := range rem {
+			if abs(c) <= tol*scale {
+				rem[k] = 0
+			}
+		}
+		a, b = b, rem.trim()
+	}
+	if len(a) == 0 {
+		return nil, nil
+	}
+	return a.Scale(1 / a[len(a)-1]), nil
+}
+
+// Func returns the polynomial as a function, so it can be used by the nonlineareq solvers
+func Func[R matrix.Real](p Polynomial[R]) nonlineareq.YEqFuncx {
+	coeffs := make([]float64, len(p))
+	for k := range p {
+		coeffs[k] = float64(p[k])
+	}
+	return func(x float64) float64 {
+		return Polynomial[float64](coeffs).Eval(x)
+	}
+}
+
+// ZFunc returns the polynomial as a complex function, so it can be used by nonlineareq.Muller
+// The polynomials with other coefficients are converted with ToComplex or ToComplex128
+func ZFunc(p Polynomial[complex128]) nonlineareq.ZEqFuncz {
+	return p.Eval
+}
+
+// ToComplex converts the real coefficients of the polynomial to complex128, so it can be used by the methods that search
+// the complex roots (e.g. DurandKerner)
+func ToComplex[R matrix.Real](p Polynomial[R]) Polynomial[complex128] {
+	coeffs := make(Polynomial[complex128], len(p))
+	for k := range p {
+		coeffs[k] = complex(float64(p[k]), 0)
+	}
+	return coeffs
+}
+
+// ToComplex128 converts the complex coefficients of the polynomial (e.g. complex64) to complex128
+func ToComplex128[C constraints.Complex](p Polynomial[C]) Polynomial[complex128] {
+	coeffs := make(Polynomial[complex128], len(p))
+	for k := range p {
+		coeffs[k] = complex128(p[k])
+	}
+	return coeffs
+}
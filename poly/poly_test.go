@@ -0,0 +1,139 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"reflect"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+func TestEval(t *testing.T) {
+	// ex. 2.2 x^3 + 4x^2 - 10
+	p := New[float64](-10, 0, 4, 1)
+	for _, x := range []float64{-2, 0, 1.5, 3} {
+		if y := p.Eval(x); y != math.Pow(x, 3)+4*math.Pow(x, 2)-10 {
+			t.Errorf("wrong evaluation at %f. expecting: %f, receiving %f", x, math.Pow(x, 3)+4*math.Pow(x, 2)-10, y)
+		}
+	}
+	// Test case: complex evaluation of a real polynomial, x^2 + 1 vanishes at i
+	if y := EvalComplex(New[int](1, 0, 1), 1i); y != 0 {
+		t.Errorf("wrong evaluation at i. expecting: 0, receiving %v", y)
+	}
+	// Test case: named types are also converted to complex numbers
+	type celsius float32
+	if y := EvalComplex(New[celsius](1, 0, 1), 1i); y != 0 {
+		t.Errorf("wrong evaluation at i of a named type. expecting: 0, receiving %v", y)
+	}
+	type phasor complex64
+	if y := ToComplex128(New[phasor](1, 0, 1)).Eval(2i); y != -3 {
+		t.Errorf("wrong evaluation at 2i of a named type. expecting: -3, receiving %v", y)
+	}
+	// Test case: complex coefficients, (x - i)(x + i) = x^2 + 1
+	q := New[complex128](-1i, 1).Mul(New[complex128](1i, 1))
+	if !reflect.DeepEqual(q, Polynomial[complex128]{1, 0, 1}) || q.Eval(2i) != -3 {
+		t.Errorf("wrong complex polynomial. expecting: [1 0 1], receiving %v", q)
+	}
+	// Test case: the trailing zero coefficients do not count
+	untrimmed := Polynomial[float64]{1, 2, 0}
+	if New[float64](0, 0).Degree() != -1 || p.Degree() != 3 || untrimmed.Degree() != 1 {
+		t.Errorf("wrong degree. expecting: -1, 3, 1, receiving %d, %d, %d", New[float64](0, 0).Degree(), p.Degree(), untrimmed.Degree())
+	}
+}
+
+type testStructArithmetic struct {
+	TestCaseName string
+	Result       Polynomial[int]
+	Expected     Polynomial[int]
+}
+
+func TestArithmetic(t *testing.T) {
+	p := New(1, 2, 3) // 3x^2 + 2x + 1
+	q := New(-1, 1)   // x - 1
+	testCases := []testStructArithmetic{
+		{"add", p.Add(q), Polynomial[int]{0, 3, 3}},
+		{"sub", p.Sub(p), nil},
+		{"mul", p.Mul(q), Polynomial[int]{-1, -1, -1, 3}},
+		{"scale", q.Scale(-2), Polynomial[int]{2, -2}},
+		{"derivative", p.Derivative(), Polynomial[int]{2, 6}},
+		{"antiderivative", Polynomial[int]{2, 6}.Antiderivative(1), Polynomial[int]{1, 2, 3}},
+		// p(x - 1) = 3x^2 - 4x + 2
+		{"compose", p.Compose(q), Polynomial[int]{2, -4, 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		if !reflect.DeepEqual(tc.Result, tc.Expected) {
+			t.Errorf("wrong result for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, tc.Result)
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	// (x^3 - 2x^2 - 4) / (x - 3) = x^2 + x + 3, remainder 5
+	quot, rem, err := New[float64](-4, 0, -2, 1).Div(New[float64](-3, 1))
+	if err != nil || !reflect.DeepEqual(quot, Polynomial[float64]{3, 1, 1}) || !reflect.DeepEqual(rem, Polynomial[float64]{5}) {
+		t.Errorf("wrong division. expecting: [3 1 1] [5], receiving %v %v (%v)", quot, rem, err)
+	}
+	// Test case: the degree of the dividend is lower than the divisor
+	quot, rem, _ = New[float64](1, 1).Div(New[float64](0, 0, 1))
+	if quot != nil || !reflect.DeepEqual(rem, Polynomial[float64]{1, 1}) {
+		t.Errorf("wrong division. expecting: [] [1 1], receiving %v %v", quot, rem)
+	}
+	// Test case: the inexact integer division keeps p = quot*q + rem
+	p := New(1, 3, 5, 7)
+	q := New(1, 2)
+	quotInt, remInt, _ := p.Div(q)
+	if !reflect.DeepEqual(quotInt.Mul(q).Add(remInt), p) || !reflect.DeepEqual(quotInt, Polynomial[int]{1, 1, 3}) {
+		t.Errorf("wrong integer division. expecting: quot*q + rem = %v, receiving %v*%v + %v", p, quotInt, q, remInt)
+	}
+	// Test case: the exact integer division leaves no remainder
+	quotInt, remInt, _ = New(-2, -1, 1).Div(New(1, 1))
+	if !reflect.DeepEqual(quotInt, Polynomial[int]{-2, 1}) || remInt != nil {
+		t.Errorf("wrong integer division. expecting: [-2 1] [], receiving %v %v", quotInt, remInt)
+	}
+	t.Logf("testing error signals")
+	if _, _, err = New[float64](1, 1).Div(nil); err != ErrDivByZero {
+		t.Errorf("wrong error. expecting: %v, receiving %v", ErrDivByZero, err)
+	}
+}
+
+func TestGCD(t *testing.T) {
+	// (x - 1)(x + 2)(x - 0.5) and (x - 1)(x - 0.5)(x + 3) share (x - 1)(x - 0.5) = x^2 - 1.5x + 0.5
+	common := New(-1.0, 1).Mul(New(-0.5, 1))
+	a := common.Mul(New(2.0, 1))
+	b := common.Mul(New(3.0, 1)).Scale(2)
+	gcd, err := GCD(a, b, 1e-12)
+	if err != nil || len(gcd) != 3 {
+		t.Fatalf("wrong gcd. expecting: %v, receiving %v (%v)", common, gcd, err)
+	}
+	for k := range gcd {
+		if math.Abs(gcd[k]-common[k]) > 1e-12 {
+			t.Errorf("wrong gcd. expecting: %v, receiving %v", common, gcd)
+		}
+	}
+	// Test case: coprime polynomials
+	if gcd, _ = GCD(New(-1.0, 1), New(1.0, 1), 1e-12); !reflect.DeepEqual(gcd, Polynomial[float64]{1}) {
+		t.Errorf("wrong gcd. expecting: [1], receiving %v", gcd)
+	}
+	// Test case: complex coefficients, x^2 + 1 and x - i share x - i
+	gcdC, _ := GCDComplex(New[complex128](1, 0, 1), New[complex128](-2i, 2), 1e-12)
+	if len(gcdC) != 2 || cmplx.Abs(gcdC[0]+1i) > 1e-12 || gcdC[1] != 1 {
+		t.Errorf("wrong gcd. expecting: [-i 1], receiving %v", gcdC)
+	}
+}
+
+func TestFunc(t *testing.T) {
+	// ex. 2.2 solved with the polynomial adapters
+	p := New[int](-10, 0, 4, 1)
+	c, _, _, err := nonlineareq.BisectBolzano(Func(p), 1, 2, 1e-12)
+	if err != nil || math.Abs(c-1.365230013414097) > 1e-11 {
+		t.Errorf("wrong estimation. expecting: %f, receiving %f (%v)", 1.365230013414097, c, err)
+	}
+	z, _, _, _, err := nonlineareq.Muller(ZFunc(ToComplex(p)), -3+1i, -2+1i, -2.5+1i, 1e-12, 1e-12, 100)
+	if err != nil || cmplx.Abs(EvalComplex(p, z)) > 1e-9 || imag(z) == 0 {
+		t.Errorf("wrong complex estimation. receiving %v (%v)", z, err)
+	}
+}
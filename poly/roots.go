@@ -0,0 +1,97 @@
+package poly
+
+import (
+	"errors"
+	"math/cmplx"
+	"sort"
+
+	"github.com/gonzalochief/NumericAll/matrix"
+)
+
+var ErrZeroPolynomial = errors.New("every number is a root of the zero polynomial")
+
+// polishIter is the maximum number of Newton-Raphson iterations of PolishRoots for each root
+const polishIter = 20
+
+// Roots estimates every (complex) root of a polynomial with real coefficients as the eigenvalues of its companion matrix
+// The roots at 0 are factored out exactly, and the roots of the remaining polynomial are the eigenvalues of the upper
+// Hessenberg companion matrix computed with matrix.Eigenvalues. The accuracy can be improved with PolishRoots
+// Input:
+// p is the polynomial
+// Output:
+// roots are the roots of p, repeated with their multiplicity and sorted by real part and then by imaginary part (the
+// complex roots come in conjugate pairs)
+func Roots[R matrix.Real](p Polynomial[R]) (roots []complex128, err error) {
+	p = p.trim()
+	if len(p) == 0 {
+		return nil, ErrZeroPolynomial
+	}
+	// x^k factor
+	k := 0
+	for p[k] == 0 {
+		k++
+	}
+	roots = make([]complex128, k, len(p)-1)
+	coeffs := p[k:]
+	n := len(coeffs) - 1
+	if n > 0 {
+		// companion matrix of the monic polynomial, the first row holds -coeffs[n-1:0]/coeffs[n]
+		comp := make([][]float64, n)
+		for i := range comp {
+			comp[i] = make([]float64, n)
+			if i > 0 {
+				comp[i][i-1] = 1
+			}
+		}
+		for j := 0; j < n; j++ {
+			comp[0][j] = -float64(coeffs[n-1-j]) / float64(coeffs[n])
+		}
+		eig, err := matrix.Eigenvalues(comp)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, eig...)
+	}
+	sortRoots(roots)
+	return roots, nil
+}
+
+// PolishRoots improves the roots of the polynomial with Newton-Raphson iterations on the original polynomial, which
+// removes the rounding errors of the eigenvalues (or of the deflation of other methods). A step is only taken if it
+// reduces |p(z)|, so the roots are never made worse
+// Input:
+// p is the polynomial (see ToComplex for the polynomials with real coefficients)
+// roots are the estimations of the roots
+// Output:
+// polished are the improved roots, in the same order
+func PolishRoots(p Polynomial[complex128], roots []complex128) (polished []complex128) {
+	dp := p.Derivative()
+	polished = make([]complex128, len(roots))
+	for k, z := range roots {
+		pz := p.Eval(z)
+		for i := 0; i < polishIter && pz != 0; i++ {
+			dpz := dp.Eval(z)
+			if dpz == 0 {
+				break
+			}
+			z1 := z - pz/dpz
+			pz1 := p.Eval(z1)
+			if !(cmplx.Abs(pz1) < cmplx.Abs(pz)) {
+				break
+			}
+			z, pz = z1, pz1
+		}
+		polished[k] = z
+	}
+	return polished
+}
+
+// sortRoots sorts the roots by real part and then by imaginary part
+func sortRoots(roots []complex128) {
+	sort.SliceStable(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+}
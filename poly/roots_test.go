@@ -0,0 +1,84 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+type testStructRoots struct {
+	TestCaseName  string
+	TestPoly      Polynomial[float64]
+	Expected      []complex128
+	Tol           float64
+	ExpectedError error
+}
+
+func TestRoots(t *testing.T) {
+	testCases := []testStructRoots{
+		{
+			// ex. 2.2 x^3 + 4x^2 - 10
+			TestCaseName: "ex. 2.2",
+			TestPoly:     New[float64](-10, 0, 4, 1),
+			Expected:     []complex128{complex(-2.6826150067070485, -0.3582593599240428), complex(-2.6826150067070485, 0.3582593599240428), 1.365230013414097},
+			Tol:          1e-12,
+		},
+		{
+			// (x - 1)(x - 2)(x^2 + 1) x^2
+			TestCaseName: "roots at 0",
+			TestPoly:     New[float64](0, 0, 2, -3, 3, -3, 1),
+			Expected:     []complex128{0, 0, -1i, 1i, 1, 2},
+			Tol:          1e-12,
+		},
+		{
+			// Wilkinson-like (x - 1)(x - 2)...(x - 8)
+			TestCaseName: "integer roots",
+			TestPoly:     New[float64](40320, -109584, 118124, -67284, 22449, -4536, 546, -36, 1),
+			Expected:     []complex128{1, 2, 3, 4, 5, 6, 7, 8},
+			Tol:          1e-8,
+		},
+		{
+			TestCaseName: "constant",
+			TestPoly:     New[float64](5),
+			Expected:     []complex128{},
+		},
+		{
+			TestCaseName:  "zero polynomial",
+			TestPoly:      New[float64](0),
+			ExpectedError: ErrZeroPolynomial,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("testing case number: %s", tc.TestCaseName)
+		roots, err := Roots(tc.TestPoly)
+		if err != tc.ExpectedError {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.ExpectedError, err)
+			continue
+		}
+		if len(roots) != len(tc.Expected) {
+			t.Errorf("wrong number of roots for case: %s. expecting: %v, receiving %v", tc.TestCaseName, tc.Expected, roots)
+			continue
+		}
+		// Test case: the polishing keeps the order of the roots and improves them
+		polished := PolishRoots(ToComplex(tc.TestPoly), roots)
+		for k := range roots {
+			if cmplx.Abs(roots[k]-tc.Expected[k]) > tc.Tol || cmplx.Abs(polished[k]-tc.Expected[k]) > tc.Tol {
+				t.Errorf("wrong roots for case: %s. expecting: %v, receiving %v (polished %v)", tc.TestCaseName, tc.Expected, roots, polished)
+				break
+			}
+			if cmplx.Abs(EvalComplex(tc.TestPoly, polished[k])) > cmplx.Abs(EvalComplex(tc.TestPoly, roots[k])) {
+				t.Errorf("wrong polishing for case: %s. root %v, polished %v", tc.TestCaseName, roots[k], polished[k])
+			}
+		}
+		t.Logf("testing case number: %s OK", tc.TestCaseName)
+	}
+
+	t.Logf("testing polishing")
+	// a perturbed root converges back to the exact one
+	p := New[int](-2, 0, 1)
+	polished := PolishRoots(ToComplex(p), []complex128{1.4, -1.4 + 1e-3i})
+	if cmplx.Abs(polished[0]-math.Sqrt2) > 1e-15 || cmplx.Abs(polished[1]+math.Sqrt2) > 1e-15 {
+		t.Errorf("wrong polishing. expecting: [%f %f], receiving %v", math.Sqrt2, -math.Sqrt2, polished)
+	}
+}
@@ -0,0 +1,182 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"sync"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+// simultaneousStep returns the correction of the root k given the monic coefficients, its derivative and every root
+type simultaneousStep func(coeffs, dCoeffs Polynomial[complex128], z []complex128, k int) complex128
+
+// DurandKerner estimates every (complex) root of the polynomial at once with the Weierstrass (Durand-Kerner) Method
+// Every root is corrected with z(k) - p(z(k)) / prod(z(k) - z(j), j != k), starting from points on a circle that contains
+// the roots. The corrections of an iteration are independent, so they can be computed in parallel
+// Inputs:
+//
+//	p is the polynomial (see ToComplex for the polynomials with real coefficients)
+//	delta is the tolerance for the roots
+//	epsilon is the tolerance for |p(z)|
+//	maxIter is the maximum iteration for the algorithm
+//	workers is the number of goroutines that correct the roots (sequential if it is lower than 2)
+//
+// Outputs:
+//
+//	roots are the roots of p, sorted by real part and then by imaginary part
+//	absErr is the largest correction of the last iteration
+//	i is the iteration that generated the approximation
+//
+// If a correction is not finite (e.g. two approximations coincide), ErrZeroDenom is returned together with the
+// approximations of the previous iteration
+func DurandKerner(p Polynomial[complex128], delta, epsilon float64, maxIter, workers int) (roots []complex128, absErr float64, i int, err error) {
+	return simultaneous(p, delta, epsilon, maxIter, workers, func(coeffs, _ Polynomial[complex128], z []complex128, k int) complex128 {
+		den := complex128(1)
+		for j := range z {
+			if j != k {
+				den *= z[k] - z[j]
+			}
+		}
+		return coeffs.Eval(z[k]) / den
+	})
+}
+
+// AberthEhrlich estimates every (complex) root of the polynomial at once with the Aberth-Ehrlich Method
+// Every root is corrected with w / (1 - w * sum(1 / (z(k) - z(j)), j != k)), where w = p(z(k)) / p'(z(k)) is the
+// Newton-Raphson correction, so the convergence is cubic for simple roots. The corrections of an iteration are
+// independent, so they can be computed in parallel
+// Inputs:
+//
+//	p is the polynomial (see ToComplex for the polynomials with real coefficients)
+//	delta is the tolerance for the roots
+//	epsilon is the tolerance for |p(z)|
+//	maxIter is the maximum iteration for the algorithm
+//	workers is the number of goroutines that correct the roots (sequential if it is lower than 2)
+//
+// Outputs:
+//
+//	roots are the roots of p, sorted by real part and then by imaginary part
+//	absErr is the largest correction of the last iteration
+//	i is the iteration that generated the approximation
+//
+// If a correction is not finite, ErrZeroDenom is returned together with the approximations of the previous iteration
+func AberthEhrlich(p Polynomial[complex128], delta, epsilon float64, maxIter, workers int) (roots []complex128, absErr float64, i int, err error) {
+	return simultaneous(p, delta, epsilon, maxIter, workers, func(coeffs, dCoeffs Polynomial[complex128], z []complex128, k int) complex128 {
+		pz := coeffs.Eval(z[k])
+		if pz == 0 {
+			return 0
+		}
+		w := pz / dCoeffs.Eval(z[k])
+		sum := complex128(0)
+		for j := range z {
+			if j != k {
+				sum += 1 / (z[k] - z[j])
+			}
+		}
+		return w / (1 - w*sum)
+	})
+}
+
+// simultaneous runs the simultaneous iteration with the correction step, all the roots are corrected with the previous
+// approximations (Jacobi style), so the result does not depend on the number of workers
+func simultaneous(p Polynomial[complex128], delta, epsilon float64, maxIter, workers int, step simultaneousStep) (roots []complex128, absErr float64, i int, err error) {
+	p = p.trim()
+	if len(p) == 0 {
+		return nil, math.NaN(), 0, ErrZeroPolynomial
+	}
+	n := len(p) - 1
+	if n == 0 {
+		return []complex128{}, 0, 0, nil
+	}
+	coeffs := make(Polynomial[complex128], len(p))
+	for k := range p {
+		coeffs[k] = p[k] / p[n]
+	}
+	dCoeffs := coeffs.Derivative()
+	z := initialRoots(coeffs)
+	next := make([]complex128, n)
+	done := make([]bool, n)
+	absErr = math.NaN()
+	for i = 0; i < maxIter; i++ {
+		parallelFor(n, workers, func(k int) {
+			dz := step(coeffs, dCoeffs, z, k)
+			next[k] = z[k] - dz
+			stepErr := cmplx.Abs(dz)
+			relErr := 2 * stepErr / (cmplx.Abs(next[k]) + delta)
+			done[k] = stepErr < delta || relErr < delta || cmplx.Abs(coeffs.Eval(next[k])) < epsilon
+		})
+		z, next = next, z
+		stepErr := 0.0
+		converged := true
+		for k := range z {
+			if cmplx.IsNaN(z[k]) || cmplx.IsInf(z[k]) {
+				// next holds the approximations of the previous iteration, which are all finite
+				roots = append([]complex128(nil), next...)
+				sortRoots(roots)
+				return roots, absErr, i, nonlineareq.ErrZeroDenom
+			}
+			stepErr = math.Max(stepErr, cmplx.Abs(z[k]-next[k]))
+			converged = converged && done[k]
+		}
+		absErr = stepErr
+		if converged {
+			roots = append([]complex128(nil), z...)
+			sortRoots(roots)
+			return roots, absErr, i, nil
+		}
+	}
+	return nil, math.NaN(), i, nonlineareq.ErrMaxIter
+}
+
+// initialRoots returns n points on a circle around the centroid of the roots of the monic polynomial, with the radius of
+// the Fujiwara bound. The angles are shifted from the real axis so the points are not symmetric under conjugation
+func initialRoots(coeffs Polynomial[complex128]) (z []complex128) {
+	n := len(coeffs) - 1
+	center := -coeffs[n-1] / complex(float64(n), 0)
+	radius := rootBound(coeffs)
+	z = make([]complex128, n)
+	for k := range z {
+		z[k] = center + cmplx.Rect(radius, 2*math.Pi*float64(k)/float64(n)+0.4)
+	}
+	return z
+}
+
+// rootBound returns the Fujiwara bound of the modulus of the roots of the polynomial (1 if every root is 0)
+func rootBound(coeffs Polynomial[complex128]) float64 {
+	n := len(coeffs) - 1
+	radius := 0.0
+	for k := 0; k < n; k++ {
+		bound := math.Pow(cmplx.Abs(coeffs[k]/coeffs[n]), 1/float64(n-k))
+		if k == 0 {
+			bound = math.Pow(cmplx.Abs(coeffs[k]/coeffs[n])/2, 1/float64(n))
+		}
+		radius = math.Max(radius, 2*bound)
+	}
+	if radius == 0 {
+		radius = 1
+	}
+	return radius
+}
+
+// parallelFor calls fn(k) for k = 0...n-1, splitting the indexes among the workers
+func parallelFor(n, workers int, fn func(k int)) {
+	if workers < 2 || n < 2 {
+		for k := 0; k < n; k++ {
+			fn(k)
+		}
+		return
+	}
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += chunk {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			for k := lo; k < hi; k++ {
+				fn(k)
+			}
+		}(lo, min(lo+chunk, n))
+	}
+	wg.Wait()
+}
@@ -0,0 +1,167 @@
+package poly
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/gonzalochief/NumericAll/nonlineareq"
+)
+
+type testStructSimultaneous struct {
+	TestCaseName string
+	TestPoly     Polynomial[complex128]
+	Expected     []complex128
+	Delta        float64
+	Tol          float64
+}
+
+// matchRoots checks that every expected root is within tol of a different root
+func matchRoots(roots, expected []complex128, tol float64) bool {
+	if len(roots) != len(expected) {
+		return false
+	}
+	used := make([]bool, len(roots))
+	for _, e := range expected {
+		found := false
+		for k := range roots {
+			if !used[k] && cmplx.Abs(roots[k]-e) <= tol {
+				used[k] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// simultaneousMethods are the simultaneous root finders with their names
+var simultaneousMethods = []struct {
+	Name   string
+	Method func(p Polynomial[complex128], delta, epsilon float64, maxIter, workers int) ([]complex128, float64, int, error)
+}{
+	{"DurandKerner", DurandKerner},
+	{"AberthEhrlich", AberthEhrlich},
+}
+
+func TestSimultaneous(t *testing.T) {
+	// (x - 1)(x - 2)...(x - 12), the rounding errors of this Wilkinson's polynomial limit the accuracy to ~1e-8
+	wilkinson := New[complex128](1)
+	for k := 1; k <= 12; k++ {
+		wilkinson = wilkinson.Mul(New(complex(-float64(k), 0), 1))
+	}
+	expectedW := make([]complex128, 12)
+	for k := range expectedW {
+		expectedW[k] = complex(float64(k+1), 0)
+	}
+	testCases := []testStructSimultaneous{
+		{
+			TestCaseName: "ex. 2.2",
+			TestPoly:     New[complex128](-10, 0, 4, 1),
+			Expected:     []complex128{complex(-2.6826150067070484, -0.3582593599240428), complex(-2.6826150067070484, 0.3582593599240428), 1.365230013414097},
+			Delta:        1e-14,
+			Tol:          1e-12,
+		},
+		{
+			// complex coefficients, (x - i)(x + 2i)(x - 3)
+			TestCaseName: "complex coefficients",
+			TestPoly:     New[complex128](-1i, 1).Mul(New[complex128](2i, 1)).Mul(New[complex128](-3, 1)),
+			Expected:     []complex128{-2i, 1i, 3},
+			Delta:        1e-14,
+			Tol:          1e-12,
+		},
+		{
+			TestCaseName: "12 integer roots",
+			TestPoly:     wilkinson,
+			Expected:     expectedW,
+			Delta:        1e-7,
+			Tol:          1e-6,
+		},
+		{
+			// (x - 1)^3, the convergence is linear and a triple root can only be resolved to ~cbrt(eps)
+			TestCaseName: "triple root",
+			TestPoly:     New[complex128](-1, 3, -3, 1),
+			Expected:     []complex128{1, 1, 1},
+			Delta:        1e-6,
+			Tol:          1e-4,
+		},
+	}
+
+	for _, m := range simultaneousMethods {
+		for _, tc := range testCases {
+			t.Logf("testing case number: %s %s", m.Name, tc.TestCaseName)
+			var sequential []complex128
+			for _, workers := range []int{1, 4} {
+				roots, _, _, err := m.Method(tc.TestPoly, tc.Delta, 0, 500, workers)
+				if err != nil {
+					t.Errorf("unexpected error for case: %s %s (%d workers). %v", m.Name, tc.TestCaseName, workers, err)
+					continue
+				}
+				if !matchRoots(roots, tc.Expected, tc.Tol) {
+					t.Errorf("wrong roots for case: %s %s (%d workers). expecting: %v, receiving %v", m.Name, tc.TestCaseName, workers, tc.Expected, roots)
+				}
+				// Test case: the parallel iteration gives the same result as the sequential one
+				if sequential == nil {
+					sequential = roots
+				} else {
+					for k := range roots {
+						if roots[k] != sequential[k] {
+							t.Errorf("wrong parallel roots for case: %s %s. expecting: %v, receiving %v", m.Name, tc.TestCaseName, sequential, roots)
+							break
+						}
+					}
+				}
+			}
+			t.Logf("testing case number: %s %s OK", m.Name, tc.TestCaseName)
+		}
+
+		t.Logf("testing error signals case number: %s", m.Name)
+		if _, _, _, err := m.Method(testCases[2].TestPoly, 1e-7, 0, 2, 1); err != nonlineareq.ErrMaxIter {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", m.Name, nonlineareq.ErrMaxIter, err)
+		}
+		if _, _, _, err := m.Method(nil, 1e-14, 0, 100, 1); err != ErrZeroPolynomial {
+			t.Errorf("wrong error for case: %s. expecting: %v, receiving %v", m.Name, ErrZeroPolynomial, err)
+		}
+		t.Logf("testing error signals case number: %s OK", m.Name)
+	}
+
+	t.Logf("testing error signals case number: non finite correction")
+	// the correction of the second iteration is not finite, the approximations of the first one are returned
+	calls := 0
+	roots, _, _, err := simultaneous(testCases[3].TestPoly, 1e-6, 0, 100, 1, func(coeffs, _ Polynomial[complex128], z []complex128, k int) complex128 {
+		calls++
+		if calls > len(z) {
+			return cmplx.Inf()
+		}
+		return coeffs.Eval(z[k]) / 10
+	})
+	if err != nonlineareq.ErrZeroDenom {
+		t.Errorf("wrong error for case: non finite correction. expecting: %v, receiving %v", nonlineareq.ErrZeroDenom, err)
+	}
+	if len(roots) != 3 {
+		t.Errorf("wrong roots for case: non finite correction. expecting 3 finite approximations, receiving %v", roots)
+	}
+	for k := range roots {
+		if cmplx.IsNaN(roots[k]) || cmplx.IsInf(roots[k]) {
+			t.Errorf("wrong roots for case: non finite correction. expecting 3 finite approximations, receiving %v", roots)
+			break
+		}
+	}
+	t.Logf("testing error signals case number: non finite correction OK")
+
+	t.Logf("testing convergence speed")
+	// the cubic convergence of Aberth-Ehrlich needs fewer iterations than the quadratic Durand-Kerner
+	_, _, iDK, _ := DurandKerner(testCases[2].TestPoly, 1e-7, 0, 500, 1)
+	_, _, iAE, _ := AberthEhrlich(testCases[2].TestPoly, 1e-7, 0, 500, 1)
+	if iAE >= iDK {
+		t.Errorf("slow convergence. Aberth-Ehrlich %d iterations, Durand-Kerner %d iterations", iAE, iDK)
+	}
+	// the function tolerance stops the iteration early
+	roots, _, _, err = AberthEhrlich(ToComplex(New[float64](-2, 0, 1)), 0, 1e-3, 100, 1)
+	if err != nil || math.Abs(cmplx.Abs(roots[0])-math.Sqrt2) > 1e-2 {
+		t.Errorf("wrong estimation. expecting: +-%f, receiving %v (%v)", math.Sqrt2, roots, err)
+	}
+}
@@ -144,3 +144,13 @@ func NaN[T constraints.Float]() (out T) {
 	}
 	return
 }
+
+// Epsilon returns the machine epsilon of the float type, i.e. the difference between 1 and the next representable number
+// (2^-52 for float64 and 2^-23 for float32).
+// The precision is checked by converting 1 + 2^-52 to T, so named types (e.g. type Celsius float32) are also handled
+func Epsilon[T constraints.Float]() T {
+	if next := T(math.Nextafter(1, 2)); next != 1 {
+		return next - 1
+	}
+	return T(math.Nextafter32(1, 2) - 1)
+}
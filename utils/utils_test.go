@@ -171,3 +171,22 @@ func BenchmarkAbsFloatGo(b *testing.B) {
 		}
 	}
 }
+
+func TestEpsilon(t *testing.T) {
+	// Test case: 1 + epsilon is the next representable number after 1
+	if eps := Epsilon[float64](); eps != math.Pow(2, -52) || 1+eps == 1 || 1+eps/2 != 1 {
+		t.Errorf("wrong value for float64, expected: %e, received: %e", math.Pow(2, -52), eps)
+	}
+	if eps := Epsilon[float32](); eps != float32(math.Pow(2, -23)) || 1+eps == 1 || 1+eps/2 != 1 {
+		t.Errorf("wrong value for float32, expected: %e, received: %e", math.Pow(2, -23), eps)
+	}
+	// Test case: named float types use the epsilon of their underlying type
+	type single float32
+	type double float64
+	if eps := Epsilon[single](); eps != single(math.Pow(2, -23)) {
+		t.Errorf("wrong value for a named float32, expected: %e, received: %e", math.Pow(2, -23), eps)
+	}
+	if eps := Epsilon[double](); eps != double(math.Pow(2, -52)) {
+		t.Errorf("wrong value for a named float64, expected: %e, received: %e", math.Pow(2, -52), eps)
+	}
+}